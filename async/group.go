@@ -0,0 +1,99 @@
+// Package async provides structured concurrency helpers.
+package async
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cirius-go/devutil/internal/panicerr"
+)
+
+// PanicError represents a panic recovered from a function run by a Group. Its Index
+// is the submission index of the panicking function.
+// It is the same type as the PanicError of the slice, record, sched and flight packages.
+type PanicError = panicerr.Error
+
+// Group runs functions in goroutines and collects their typed results, like errgroup
+// without a shared result slice. The first failure cancels the context passed to every
+// function. A Group must be created with New and must not be reused after Wait.
+type Group[T any] struct {
+	ctx     context.Context
+	cancel  context.CancelCauseFunc
+	sem     chan struct{}
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	results []T
+	err     error
+}
+
+// New creates a Group whose functions receive a context derived from ctx.
+func New[T any](ctx context.Context) *Group[T] {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return &Group[T]{ctx: ctx, cancel: cancel}
+}
+
+// WithLimit limits the number of functions running at once to n. Go blocks while the
+// limit is reached. n <= 0 means no limit. It must be called before Go.
+func (g *Group[T]) WithLimit(n int) *Group[T] {
+	g.sem = nil
+	if n > 0 {
+		g.sem = make(chan struct{}, n)
+	}
+	return g
+}
+
+// Context returns the context passed to the functions. It is cancelled, with the error
+// as its cause, when a function fails, and when Wait returns.
+func (g *Group[T]) Context() context.Context {
+	return g.ctx
+}
+
+// Go runs fn in a new goroutine. Its result is stored at the position matching the
+// order of Go calls. If fn returns an error or panics, the group's context is
+// cancelled; a panic is reported as a *PanicError.
+func (g *Group[T]) Go(fn func(ctx context.Context) (T, error)) {
+	g.mu.Lock()
+	index := len(g.results)
+	var zero T
+	g.results = append(g.results, zero)
+	g.mu.Unlock()
+
+	if g.sem != nil {
+		g.sem <- struct{}{} // Acquire token
+	}
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if g.sem != nil {
+			defer func() { <-g.sem }() // Release token
+		}
+		v, err := g.call(index, fn)
+
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		g.results[index] = v
+		if err != nil && g.err == nil {
+			g.err = err
+			g.cancel(err)
+		}
+	}()
+}
+
+// call runs fn, recovering a panic as a *PanicError.
+func (g *Group[T]) call(index int, fn func(ctx context.Context) (T, error)) (v T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicerr.New("task", index, r)
+		}
+	}()
+	return fn(g.ctx)
+}
+
+// Wait waits for every function to return, then returns their results in the order of
+// the Go calls, and the first error, if any. Results of failed functions are whatever
+// they returned, usually the zero value.
+func (g *Group[T]) Wait() ([]T, error) {
+	g.wg.Wait()
+	g.cancel(nil)
+	return g.results, g.err
+}
@@ -0,0 +1,104 @@
+package async_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cirius-go/devutil/async"
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestGroup_ResultsInSubmissionOrder(t *testing.T) {
+	g := async.New[int](context.Background())
+	for i := range 10 {
+		g.Go(func(context.Context) (int, error) {
+			time.Sleep(time.Duration(10-i) * time.Millisecond)
+			return i * i, nil
+		})
+	}
+	got, err := g.Wait()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []int{0, 1, 4, 9, 16, 25, 36, 49, 64, 81}
+	if !slices.Equal(got, want) {
+		t.Errorf("Wait() = %v, want %v", got, want)
+	}
+}
+
+func TestGroup_WithLimit(t *testing.T) {
+	var running, peak atomic.Int32
+	g := async.New[struct{}](context.Background()).WithLimit(3)
+	for range 12 {
+		g.Go(func(context.Context) (struct{}, error) {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+			return struct{}{}, nil
+		})
+	}
+	if _, err := g.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if peak.Load() > 3 {
+		t.Errorf("expected at most 3 concurrent functions, saw %d", peak.Load())
+	}
+}
+
+func TestGroup_FirstErrorCancels(t *testing.T) {
+	errFail := errors.New("fail")
+	g := async.New[string](context.Background())
+	g.Go(func(ctx context.Context) (string, error) {
+		select {
+		case <-ctx.Done():
+			return "cancelled", context.Cause(ctx)
+		case <-time.After(time.Second):
+			return "timed out", nil
+		}
+	})
+	g.Go(func(context.Context) (string, error) {
+		return "", errFail
+	})
+
+	got, err := g.Wait()
+	if !errors.Is(err, errFail) {
+		t.Errorf("expected errFail, got %v", err)
+	}
+	if got[0] != "cancelled" {
+		t.Errorf("expected the first function to see the cancellation, got %q", got[0])
+	}
+	if g.Context().Err() == nil {
+		t.Error("expected the context to be cancelled after Wait")
+	}
+}
+
+func TestGroup_Panic(t *testing.T) {
+	g := async.New[int](context.Background())
+	g.Go(func(context.Context) (int, error) { return 1, nil })
+	g.Go(func(context.Context) (int, error) { panic("boom") })
+
+	_, err := g.Wait()
+	var panicErr *async.PanicError
+	if !errors.As(err, &panicErr) || panicErr.Index != 1 || panicErr.Value != "boom" {
+		t.Errorf("expected PanicError for task 1, got %v", err)
+	}
+	if err.Error() != "panic in task 1: boom" {
+		t.Errorf("unexpected message %q", err.Error())
+	}
+
+	// The same type is shared with the other packages that recover panics.
+	var slicePanic *slice.PanicError
+	if !errors.As(err, &slicePanic) {
+		t.Errorf("expected errors.As to match slice.PanicError, got %v", err)
+	}
+}
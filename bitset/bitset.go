@@ -0,0 +1,107 @@
+// Package bitset provides a compact set of non-negative integers.
+package bitset
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+)
+
+// BitSet is a set of non-negative integers stored as one bit per value, growing as
+// needed to hold the largest value. It is much smaller than a map[int]struct{} for
+// dense values such as sequential IDs.
+// The zero value is an empty set ready to use. A BitSet is not safe for concurrent use.
+type BitSet struct {
+	words []uint64
+}
+
+// New creates an empty set with room for values below capacity without growing.
+func New(capacity int) *BitSet {
+	return &BitSet{words: make([]uint64, 0, (max(capacity, 0)+63)/64)}
+}
+
+// Of creates a set holding values.
+func Of(values ...int) *BitSet {
+	b := &BitSet{}
+	for _, v := range values {
+		b.Set(v)
+	}
+	return b
+}
+
+// Set adds i to the set. It panics if i is negative.
+func (b *BitSet) Set(i int) {
+	if i < 0 {
+		panic(fmt.Sprintf("bitset: negative value %d", i))
+	}
+	w := i / 64
+	if w >= len(b.words) {
+		b.words = append(b.words, make([]uint64, w+1-len(b.words))...)
+	}
+	b.words[w] |= 1 << (uint(i) % 64)
+}
+
+// Clear removes i from the set.
+func (b *BitSet) Clear(i int) {
+	if w := i / 64; i >= 0 && w < len(b.words) {
+		b.words[w] &^= 1 << (uint(i) % 64)
+	}
+}
+
+// Test reports whether i is in the set.
+func (b *BitSet) Test(i int) bool {
+	w := i / 64
+	return i >= 0 && w < len(b.words) && b.words[w]&(1<<(uint(i)%64)) != 0
+}
+
+// Count returns the number of values in the set.
+func (b *BitSet) Count() int {
+	n := 0
+	for _, w := range b.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Union returns a new set holding the values in b or other.
+func (b *BitSet) Union(other *BitSet) *BitSet {
+	long, short := b.words, other.words
+	if len(long) < len(short) {
+		long, short = short, long
+	}
+	words := make([]uint64, len(long))
+	copy(words, long)
+	for i, w := range short {
+		words[i] |= w
+	}
+	return &BitSet{words: words}
+}
+
+// Intersect returns a new set holding the values in both b and other.
+func (b *BitSet) Intersect(other *BitSet) *BitSet {
+	words := make([]uint64, min(len(b.words), len(other.words)))
+	for i := range words {
+		words[i] = b.words[i] & other.words[i]
+	}
+	return &BitSet{words: words}
+}
+
+// Clone returns a copy of the set.
+func (b *BitSet) Clone() *BitSet {
+	return &BitSet{words: append([]uint64(nil), b.words...)}
+}
+
+// All returns an iterator over the values in the set, in ascending order.
+func (b *BitSet) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i, w := range b.words {
+			for w != 0 {
+				bit := bits.TrailingZeros64(w)
+				if !yield(i*64 + bit) {
+					return
+				}
+				w &= w - 1 // clear the lowest set bit
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package bitset_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/cirius-go/devutil/bitset"
+)
+
+func TestBitSet(t *testing.T) {
+	var b bitset.BitSet
+	for _, v := range []int{0, 3, 64, 200, 3} {
+		b.Set(v)
+	}
+	if b.Count() != 4 {
+		t.Errorf("Count() = %d, want 4", b.Count())
+	}
+	for _, v := range []int{0, 3, 64, 200} {
+		if !b.Test(v) {
+			t.Errorf("Test(%d) = false, want true", v)
+		}
+	}
+	for _, v := range []int{-1, 1, 63, 199, 10_000} {
+		if b.Test(v) {
+			t.Errorf("Test(%d) = true, want false", v)
+		}
+	}
+
+	b.Clear(64)
+	b.Clear(10_000) // out of range is a no-op
+	b.Clear(-5)
+	if got := slices.Collect(b.All()); !slices.Equal(got, []int{0, 3, 200}) {
+		t.Errorf("All() = %v, want [0 3 200]", got)
+	}
+}
+
+func TestBitSet_SetOperations(t *testing.T) {
+	a := bitset.Of(1, 2, 130)
+	b := bitset.Of(2, 3)
+
+	if got := slices.Collect(a.Union(b).All()); !slices.Equal(got, []int{1, 2, 3, 130}) {
+		t.Errorf("Union() = %v", got)
+	}
+	if got := slices.Collect(b.Union(a).All()); !slices.Equal(got, []int{1, 2, 3, 130}) {
+		t.Errorf("Union() reversed = %v", got)
+	}
+	if got := slices.Collect(a.Intersect(b).All()); !slices.Equal(got, []int{2}) {
+		t.Errorf("Intersect() = %v", got)
+	}
+	if !a.Test(130) || a.Test(3) {
+		t.Error("Union and Intersect must not modify their operands")
+	}
+
+	c := a.Clone()
+	c.Set(7)
+	if a.Test(7) {
+		t.Error("Clone() shares storage with the original")
+	}
+}
+
+func TestBitSet_AllStopsEarly(t *testing.T) {
+	var got []int
+	for v := range bitset.Of(5, 10, 15).All() {
+		got = append(got, v)
+		if v == 10 {
+			break
+		}
+	}
+	if !slices.Equal(got, []int{5, 10}) {
+		t.Errorf("expected iteration to stop at 10, got %v", got)
+	}
+}
+
+func TestBitSet_SetNegativePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Set(-1) to panic")
+		}
+	}()
+	bitset.New(10).Set(-1)
+}
@@ -0,0 +1,139 @@
+// Package cache provides a size-bounded cache with selectable eviction policies.
+package cache
+
+import "sync"
+
+// Stats reports the activity of a Cache.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// HitRate returns the fraction of lookups that were hits, or 0 if there were none.
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// Cache is a map holding at most a fixed number of entries, evicting entries chosen by
+// its Policy to make room for new ones.
+// A Cache is safe for concurrent use. Configure it with OnEvict before sharing it
+// between goroutines.
+type Cache[K comparable, V any] struct {
+	mu       sync.Mutex
+	entries  map[K]V
+	evictor  evictor[K]
+	capacity int
+	onEvict  func(key K, value V)
+	stats    Stats
+}
+
+// New creates a cache holding at most capacity entries, evicted according to policy.
+// capacity < 1 is treated as 1.
+func New[K comparable, V any](policy Policy, capacity int) *Cache[K, V] {
+	capacity = max(capacity, 1)
+	return &Cache[K, V]{
+		entries:  make(map[K]V, capacity),
+		evictor:  newEvictor[K](policy),
+		capacity: capacity,
+	}
+}
+
+// OnEvict registers fn to be called for every entry evicted to make room. It is not
+// called for entries removed with Delete or replaced with Set. fn is called without
+// holding the cache's lock, so it may use the cache.
+func (c *Cache[K, V]) OnEvict(fn func(key K, value V)) *Cache[K, V] {
+	c.onEvict = fn
+	return c
+}
+
+// Get returns the value stored under key and records a use of it. The bool is false
+// if the key is not cached.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return v, false
+	}
+	c.stats.Hits++
+	c.evictor.touch(key)
+	return v, true
+}
+
+// Peek returns the value stored under key without recording a use or counting a hit.
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.entries[key]
+	return v, ok
+}
+
+// Set stores value under key, counting as a use of the key. If the cache is full and
+// key is new, an entry is evicted first.
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	if _, ok := c.entries[key]; ok {
+		c.entries[key] = value
+		c.evictor.touch(key)
+		c.mu.Unlock()
+		return
+	}
+
+	var (
+		evicted      bool
+		evictedKey   K
+		evictedValue V
+	)
+	if len(c.entries) >= c.capacity {
+		evictedKey = c.evictor.victim()
+		evictedValue = c.entries[evictedKey]
+		c.evictor.remove(evictedKey)
+		delete(c.entries, evictedKey)
+		c.stats.Evictions++
+		evicted = true
+	}
+	c.entries[key] = value
+	c.evictor.add(key)
+	c.mu.Unlock()
+
+	if evicted && c.onEvict != nil {
+		c.onEvict(evictedKey, evictedValue)
+	}
+}
+
+// Delete removes key from the cache and reports whether it was present.
+func (c *Cache[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok {
+		return false
+	}
+	c.evictor.remove(key)
+	delete(c.entries, key)
+	return true
+}
+
+// Len returns the number of cached entries.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Cap returns the maximum number of entries.
+func (c *Cache[K, V]) Cap() int {
+	return c.capacity
+}
+
+// Stats returns the hit, miss and eviction counts so far.
+func (c *Cache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
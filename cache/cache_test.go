@@ -0,0 +1,138 @@
+package cache_test
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/cirius-go/devutil/cache"
+)
+
+// evictions records the keys evicted from c, in order.
+func evictions[V any](c *cache.Cache[string, V]) *[]string {
+	var keys []string
+	c.OnEvict(func(k string, _ V) { keys = append(keys, k) })
+	return &keys
+}
+
+func TestCache_LRU(t *testing.T) {
+	c := cache.New[string, int](cache.LRU, 2)
+	evicted := evictions(c)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a") // b is now least recently used
+	c.Set("c", 3)
+
+	if _, ok := c.Peek("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	if !slices.Equal(*evicted, []string{"b"}) {
+		t.Errorf("evicted %v, want [b]", *evicted)
+	}
+}
+
+func TestCache_FIFO(t *testing.T) {
+	c := cache.New[string, int](cache.FIFO, 2)
+	evicted := evictions(c)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a") // use does not matter for FIFO
+	c.Set("c", 3)
+
+	if !slices.Equal(*evicted, []string{"a"}) {
+		t.Errorf("evicted %v, want [a]", *evicted)
+	}
+}
+
+func TestCache_LFU(t *testing.T) {
+	c := cache.New[string, int](cache.LFU, 3)
+	evicted := evictions(c)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("c")
+	c.Set("d", 4) // b has the fewest uses
+	c.Set("e", 5) // d has one use, c has two
+
+	if !slices.Equal(*evicted, []string{"b", "d"}) {
+		t.Errorf("evicted %v, want [b d]", *evicted)
+	}
+
+	// Ties are broken by insertion order.
+	c2 := cache.New[string, int](cache.LFU, 2)
+	evicted2 := evictions(c2)
+	c2.Set("x", 1)
+	c2.Set("y", 2)
+	c2.Set("z", 3)
+	if !slices.Equal(*evicted2, []string{"x"}) {
+		t.Errorf("evicted %v, want [x]", *evicted2)
+	}
+}
+
+func TestCache_SetDeleteStats(t *testing.T) {
+	c := cache.New[string, int](cache.LRU, 2)
+	evicted := evictions(c)
+
+	c.Set("a", 1)
+	c.Set("a", 10) // replace, no eviction
+	if v, ok := c.Get("a"); !ok || v != 10 {
+		t.Errorf("Get(a) = %v, %v, want 10, true", v, ok)
+	}
+	if _, ok := c.Get("missing"); ok {
+		t.Error("Get(missing) reported ok")
+	}
+	if !c.Delete("a") || c.Delete("a") {
+		t.Error("Delete() should report presence once")
+	}
+	if c.Len() != 0 || len(*evicted) != 0 {
+		t.Errorf("Len() = %d, evicted %v", c.Len(), *evicted)
+	}
+
+	c.Set("x", 1)
+	c.Set("y", 2)
+	c.Set("z", 3)
+	stats := c.Stats()
+	if stats.Hits != 1 || stats.Misses != 1 || stats.Evictions != 1 {
+		t.Errorf("Stats() = %+v", stats)
+	}
+	if stats.HitRate() != 0.5 {
+		t.Errorf("HitRate() = %v, want 0.5", stats.HitRate())
+	}
+	if c.Cap() != 2 || c.Len() != 2 {
+		t.Errorf("Cap() = %d, Len() = %d", c.Cap(), c.Len())
+	}
+}
+
+func TestCache_Concurrent(t *testing.T) {
+	for _, policy := range []cache.Policy{cache.LRU, cache.LFU, cache.FIFO} {
+		t.Run(policy.String(), func(t *testing.T) {
+			c := cache.New[string, int](policy, 16)
+			var wg sync.WaitGroup
+			for g := range 8 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := range 200 {
+						key := fmt.Sprint((g*7 + i) % 40)
+						if _, ok := c.Get(key); !ok {
+							c.Set(key, i)
+						}
+						if i%17 == 0 {
+							c.Delete(key)
+						}
+					}
+				}()
+			}
+			wg.Wait()
+			if c.Len() > 16 {
+				t.Errorf("Len() = %d exceeds capacity", c.Len())
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package cache
+
+import (
+	"container/heap"
+	"container/list"
+)
+
+// Policy selects which entry a Cache evicts when it is full.
+type Policy int
+
+const (
+	// LRU evicts the least recently used entry.
+	LRU Policy = iota
+	// LFU evicts the least frequently used entry, the oldest one among ties.
+	LFU
+	// FIFO evicts the oldest inserted entry, regardless of use.
+	FIFO
+)
+
+// String returns the name of the policy.
+func (p Policy) String() string {
+	switch p {
+	case LRU:
+		return "LRU"
+	case LFU:
+		return "LFU"
+	case FIFO:
+		return "FIFO"
+	default:
+		return "unknown"
+	}
+}
+
+// evictor tracks key usage for a Policy and chooses eviction victims.
+type evictor[K comparable] interface {
+	// add starts tracking a new key.
+	add(key K)
+	// touch records a use of a tracked key.
+	touch(key K)
+	// remove stops tracking a key.
+	remove(key K)
+	// victim returns the key to evict. It is only called when keys are tracked.
+	victim() K
+}
+
+// newEvictor returns the evictor implementing p. Unknown policies fall back to LRU.
+func newEvictor[K comparable](p Policy) evictor[K] {
+	switch p {
+	case LFU:
+		return &lfuEvictor[K]{items: make(map[K]*lfuItem[K])}
+	case FIFO:
+		return &listEvictor[K]{order: list.New(), elems: make(map[K]*list.Element), moveOnTouch: false}
+	default:
+		return &listEvictor[K]{order: list.New(), elems: make(map[K]*list.Element), moveOnTouch: true}
+	}
+}
+
+// listEvictor implements LRU and FIFO with a list ordered from newest to oldest.
+type listEvictor[K comparable] struct {
+	order       *list.List
+	elems       map[K]*list.Element
+	moveOnTouch bool
+}
+
+func (e *listEvictor[K]) add(key K) {
+	e.elems[key] = e.order.PushFront(key)
+}
+
+func (e *listEvictor[K]) touch(key K) {
+	if e.moveOnTouch {
+		e.order.MoveToFront(e.elems[key])
+	}
+}
+
+func (e *listEvictor[K]) remove(key K) {
+	e.order.Remove(e.elems[key])
+	delete(e.elems, key)
+}
+
+func (e *listEvictor[K]) victim() K {
+	return e.order.Back().Value.(K)
+}
+
+// lfuItem is a key tracked by lfuEvictor.
+type lfuItem[K comparable] struct {
+	key   K
+	freq  int
+	seq   uint64 // insertion order, to break ties
+	index int    // position in the heap
+}
+
+// lfuEvictor implements LFU with a min-heap ordered by use count, then insertion order.
+type lfuEvictor[K comparable] struct {
+	heap  lfuHeap[K]
+	items map[K]*lfuItem[K]
+	seq   uint64
+}
+
+func (e *lfuEvictor[K]) add(key K) {
+	e.seq++
+	item := &lfuItem[K]{key: key, freq: 1, seq: e.seq}
+	e.items[key] = item
+	heap.Push(&e.heap, item)
+}
+
+func (e *lfuEvictor[K]) touch(key K) {
+	item := e.items[key]
+	item.freq++
+	heap.Fix(&e.heap, item.index)
+}
+
+func (e *lfuEvictor[K]) remove(key K) {
+	heap.Remove(&e.heap, e.items[key].index)
+	delete(e.items, key)
+}
+
+func (e *lfuEvictor[K]) victim() K {
+	return e.heap[0].key
+}
+
+// lfuHeap implements heap.Interface for lfuEvictor.
+type lfuHeap[K comparable] []*lfuItem[K]
+
+func (h lfuHeap[K]) Len() int { return len(h) }
+
+func (h lfuHeap[K]) Less(i, j int) bool {
+	if h[i].freq != h[j].freq {
+		return h[i].freq < h[j].freq
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h lfuHeap[K]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index, h[j].index = i, j
+}
+
+func (h *lfuHeap[K]) Push(x any) {
+	item := x.(*lfuItem[K])
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *lfuHeap[K]) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return item
+}
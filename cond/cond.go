@@ -0,0 +1,80 @@
+// Package cond provides expression-style helpers for choosing between values.
+package cond
+
+// If returns a if cond is true, and b otherwise.
+// Both values are evaluated before the call; see IfFunc for lazy evaluation.
+func If[T any](cond bool, a, b T) T {
+	if cond {
+		return a
+	}
+	return b
+}
+
+// IfFunc returns the result of a if cond is true, and of b otherwise.
+// Only the selected function is called.
+func IfFunc[T any](cond bool, a, b func() T) T {
+	if cond {
+		return a()
+	}
+	return b()
+}
+
+// Coalesce returns the first of values that is not the zero value of T,
+// or the zero value if there is none.
+func Coalesce[T comparable](values ...T) T {
+	var zero T
+	for _, v := range values {
+		if v != zero {
+			return v
+		}
+	}
+	return zero
+}
+
+// Switcher selects a result by comparing a value against a series of cases.
+// The first matching case wins. Build one with Switch.
+type Switcher[T comparable, R any] struct {
+	value   T
+	result  R
+	matched bool
+}
+
+// Switch starts a switch expression over value.
+func Switch[T comparable, R any](value T) *Switcher[T, R] {
+	return &Switcher[T, R]{value: value}
+}
+
+// Case selects result if the value equals any of matches.
+func (s *Switcher[T, R]) Case(result R, matches ...T) *Switcher[T, R] {
+	if s.matched {
+		return s
+	}
+	for _, m := range matches {
+		if s.value == m {
+			s.result, s.matched = result, true
+			break
+		}
+	}
+	return s
+}
+
+// When selects result if predicate reports true for the value.
+func (s *Switcher[T, R]) When(predicate func(value T) bool, result R) *Switcher[T, R] {
+	if !s.matched && predicate(s.value) {
+		s.result, s.matched = result, true
+	}
+	return s
+}
+
+// Default returns the selected result, or fallback if no case matched.
+func (s *Switcher[T, R]) Default(fallback R) R {
+	if s.matched {
+		return s.result
+	}
+	return fallback
+}
+
+// Result returns the selected result and true, or the zero value and false if no case matched.
+func (s *Switcher[T, R]) Result() (R, bool) {
+	return s.result, s.matched
+}
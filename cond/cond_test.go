@@ -0,0 +1,87 @@
+package cond_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/cond"
+)
+
+func TestIf(t *testing.T) {
+	if got := cond.If(true, "yes", "no"); got != "yes" {
+		t.Errorf("If(true) = %q", got)
+	}
+	if got := cond.If(false, 1, 2); got != 2 {
+		t.Errorf("If(false) = %d", got)
+	}
+}
+
+func TestIfFunc(t *testing.T) {
+	calls := 0
+	a := func() int { calls++; return 1 }
+	b := func() int { t.Error("unselected branch called"); return 2 }
+	if got := cond.IfFunc(true, a, b); got != 1 || calls != 1 {
+		t.Errorf("IfFunc(true) = %d, calls = %d", got, calls)
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{name: "first non-empty", values: []string{"", "b", "c"}, want: "b"},
+		{name: "first wins", values: []string{"a", "b"}, want: "a"},
+		{name: "all empty", values: []string{"", ""}, want: ""},
+		{name: "no values", values: nil, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cond.Coalesce(tt.values...); got != tt.want {
+				t.Errorf("Coalesce() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSwitch(t *testing.T) {
+	label := func(code int) string {
+		return cond.Switch[int, string](code).
+			Case("ok", 200, 204).
+			Case("not found", 404).
+			When(func(c int) bool { return c >= 500 }, "server error").
+			Default("other")
+	}
+
+	tests := []struct {
+		code int
+		want string
+	}{
+		{200, "ok"},
+		{204, "ok"},
+		{404, "not found"},
+		{503, "server error"},
+		{302, "other"},
+	}
+	for _, tt := range tests {
+		if got := label(tt.code); got != tt.want {
+			t.Errorf("label(%d) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestSwitch_FirstMatchWins(t *testing.T) {
+	got, ok := cond.Switch[string, int]("a").
+		Case(1, "a").
+		Case(2, "a").
+		When(func(string) bool { return true }, 3).
+		Result()
+	if !ok || got != 1 {
+		t.Errorf("Result() = %d, %v, want 1, true", got, ok)
+	}
+
+	if _, ok := cond.Switch[string, int]("z").Case(1, "a").Result(); ok {
+		t.Error("Result() should report no match")
+	}
+}
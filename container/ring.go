@@ -0,0 +1,155 @@
+// Package container provides generic fixed-size containers.
+package container
+
+import "sync"
+
+// Policy decides what Push does when a ring is full.
+type Policy int
+
+const (
+	// Overwrite makes Push drop the oldest element to make room.
+	Overwrite Policy = iota
+	// Reject makes Push refuse the new element.
+	Reject
+)
+
+// Ring is a fixed-capacity FIFO buffer, typically used to keep the last N events.
+// A Ring is not safe for concurrent use; see SyncRing.
+type Ring[T any] struct {
+	buf    []T
+	head   int // index of the oldest element
+	size   int
+	policy Policy
+}
+
+// NewRing creates a ring holding at most capacity elements, with the given policy for
+// pushes to a full ring. capacity < 1 is treated as 1.
+func NewRing[T any](capacity int, policy Policy) *Ring[T] {
+	return &Ring[T]{buf: make([]T, max(capacity, 1)), policy: policy}
+}
+
+// Push adds v as the newest element. If the ring is full, the oldest element is
+// dropped under Overwrite, and v is dropped under Reject.
+// It reports whether v was added.
+func (r *Ring[T]) Push(v T) bool {
+	if r.size < len(r.buf) {
+		r.buf[(r.head+r.size)%len(r.buf)] = v
+		r.size++
+		return true
+	}
+	if r.policy == Reject {
+		return false
+	}
+	r.buf[r.head] = v
+	r.head = (r.head + 1) % len(r.buf)
+	return true
+}
+
+// Pop removes and returns the oldest element. The bool is false if the ring is empty.
+func (r *Ring[T]) Pop() (T, bool) {
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+	v := r.buf[r.head]
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) % len(r.buf)
+	r.size--
+	return v, true
+}
+
+// Peek returns the oldest element without removing it. The bool is false if the ring is empty.
+func (r *Ring[T]) Peek() (T, bool) {
+	if r.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.buf[r.head], true
+}
+
+// Len returns the number of elements in the ring.
+func (r *Ring[T]) Len() int {
+	return r.size
+}
+
+// Cap returns the capacity of the ring.
+func (r *Ring[T]) Cap() int {
+	return len(r.buf)
+}
+
+// Full reports whether the ring holds Cap elements.
+func (r *Ring[T]) Full() bool {
+	return r.size == len(r.buf)
+}
+
+// Snapshot returns a copy of the elements, oldest first.
+func (r *Ring[T]) Snapshot() []T {
+	result := make([]T, r.size)
+	n := copy(result, r.buf[r.head:min(r.head+r.size, len(r.buf))])
+	copy(result[n:], r.buf)
+	return result
+}
+
+// Clear removes all elements.
+func (r *Ring[T]) Clear() {
+	clear(r.buf)
+	r.head, r.size = 0, 0
+}
+
+// SyncRing is a Ring that is safe for concurrent use.
+type SyncRing[T any] struct {
+	mu   sync.Mutex
+	ring *Ring[T]
+}
+
+// NewSyncRing creates a concurrency-safe ring; see NewRing.
+func NewSyncRing[T any](capacity int, policy Policy) *SyncRing[T] {
+	return &SyncRing[T]{ring: NewRing[T](capacity, policy)}
+}
+
+// Push adds v as the newest element; see Ring.Push.
+func (r *SyncRing[T]) Push(v T) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ring.Push(v)
+}
+
+// Pop removes and returns the oldest element; see Ring.Pop.
+func (r *SyncRing[T]) Pop() (T, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ring.Pop()
+}
+
+// Peek returns the oldest element without removing it; see Ring.Peek.
+func (r *SyncRing[T]) Peek() (T, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ring.Peek()
+}
+
+// Len returns the number of elements in the ring.
+func (r *SyncRing[T]) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ring.Len()
+}
+
+// Cap returns the capacity of the ring.
+func (r *SyncRing[T]) Cap() int {
+	return r.ring.Cap()
+}
+
+// Snapshot returns a copy of the elements, oldest first.
+func (r *SyncRing[T]) Snapshot() []T {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ring.Snapshot()
+}
+
+// Clear removes all elements.
+func (r *SyncRing[T]) Clear() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ring.Clear()
+}
@@ -0,0 +1,90 @@
+package container_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/cirius-go/devutil/container"
+)
+
+func TestRing_Overwrite(t *testing.T) {
+	r := container.NewRing[int](3, container.Overwrite)
+	for i := 1; i <= 5; i++ {
+		if !r.Push(i) {
+			t.Fatalf("Push(%d) = false under Overwrite", i)
+		}
+	}
+	if got := r.Snapshot(); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("Snapshot() = %v, want [3 4 5]", got)
+	}
+	if !r.Full() || r.Len() != 3 || r.Cap() != 3 {
+		t.Errorf("Full() = %v, Len() = %d, Cap() = %d", r.Full(), r.Len(), r.Cap())
+	}
+}
+
+func TestRing_Reject(t *testing.T) {
+	r := container.NewRing[string](2, container.Reject)
+	r.Push("a")
+	r.Push("b")
+	if r.Push("c") {
+		t.Error("Push() = true on a full ring under Reject")
+	}
+	if got := r.Snapshot(); !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("Snapshot() = %v, want [a b]", got)
+	}
+}
+
+func TestRing_PopPeek(t *testing.T) {
+	r := container.NewRing[int](3, container.Overwrite)
+	if _, ok := r.Pop(); ok {
+		t.Error("Pop() on empty ring reported ok")
+	}
+	for i := 1; i <= 4; i++ {
+		r.Push(i)
+	}
+	if v, ok := r.Peek(); !ok || v != 2 {
+		t.Errorf("Peek() = %v, %v, want 2, true", v, ok)
+	}
+	if v, _ := r.Pop(); v != 2 {
+		t.Errorf("Pop() = %v, want 2", v)
+	}
+	r.Push(5)
+	r.Push(6)
+	if got := r.Snapshot(); !slices.Equal(got, []int{4, 5, 6}) {
+		t.Errorf("Snapshot() after wraparound = %v, want [4 5 6]", got)
+	}
+
+	r.Clear()
+	if r.Len() != 0 || len(r.Snapshot()) != 0 {
+		t.Errorf("expected empty ring after Clear, got %v", r.Snapshot())
+	}
+}
+
+func TestNewRing_MinimumCapacity(t *testing.T) {
+	r := container.NewRing[int](0, container.Overwrite)
+	r.Push(1)
+	r.Push(2)
+	if got := r.Snapshot(); !slices.Equal(got, []int{2}) {
+		t.Errorf("Snapshot() = %v, want [2]", got)
+	}
+}
+
+func TestSyncRing(t *testing.T) {
+	r := container.NewSyncRing[int](10, container.Overwrite)
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				r.Push(g*100 + i)
+				_ = r.Snapshot()
+			}
+		}()
+	}
+	wg.Wait()
+	if r.Len() != 10 {
+		t.Errorf("Len() = %d, want 10", r.Len())
+	}
+}
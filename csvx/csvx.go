@@ -0,0 +1,261 @@
+// Package csvx converts between CSV data and slices of structs using `csv` struct tags.
+package csvx
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+// tagName is the struct tag key used to customize column names.
+const tagName = "csv"
+
+// column describes an exported struct field and the CSV column it is bound to.
+type column struct {
+	index int
+	name  string
+}
+
+// columns returns the columns of a struct type, honoring `csv` tags.
+// A tag of "-" skips the field.
+func columns(t reflect.Type) ([]column, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("csvx: element type must be a struct, got %s", t)
+	}
+	cols := make([]column, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get(tagName), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		cols = append(cols, column{index: i, name: name})
+	}
+	return cols, nil
+}
+
+// Marshal encodes the items as CSV, writing a header row followed by one row per item.
+// Columns follow the struct field order; the `csv:"name"` tag overrides the header
+// and `csv:"-"` skips the field.
+func Marshal[T any](items []T) ([]byte, error) {
+	cols, err := columns(reflect.TypeFor[T]())
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	header := make([]string, len(cols))
+	for i, c := range cols {
+		header[i] = c.name
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	record := make([]string, len(cols))
+	for row, item := range items {
+		rv := reflect.ValueOf(item)
+		for i, c := range cols {
+			s, err := formatValue(rv.Field(c.index))
+			if err != nil {
+				return nil, fmt.Errorf("csvx: row %d, column %q: %w", row, c.name, err)
+			}
+			record[i] = s
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes CSV data with a header row into a slice of structs.
+// Header names are matched against field names or their `csv:"name"` tags;
+// unknown columns are ignored.
+func Unmarshal[T any](r io.Reader) ([]T, error) {
+	var result []T
+	err := decode(r, func(item T) error {
+		result = append(result, item)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DecodeEach streams CSV data with a header row, decoding rows into structs and feeding
+// them to slice.ForEachChunk in chunks of chunkSize, with up to concurrency handlers at a time.
+// At most chunkSize*concurrency rows are held in memory at once.
+// It stops and returns the first decoding or handler error.
+func DecodeEach[T any](r io.Reader, chunkSize, concurrency int, handler func(chunk []T) error) error {
+	if chunkSize <= 0 {
+		chunkSize = 1
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	batchSize := chunkSize * concurrency
+	batch := make([]T, 0, batchSize)
+
+	err := decode(r, func(item T) error {
+		batch = append(batch, item)
+		if len(batch) < batchSize {
+			return nil
+		}
+		err := slice.ForEachChunk(batch, chunkSize, concurrency, handler)
+		batch = make([]T, 0, batchSize)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return slice.ForEachChunk(batch, chunkSize, concurrency, handler)
+}
+
+// decode reads the header and each row of r, calling fn with every decoded item.
+func decode[T any](r io.Reader, fn func(item T) error) error {
+	cols, err := columns(reflect.TypeFor[T]())
+	if err != nil {
+		return err
+	}
+
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	byName := make(map[string]column, len(cols))
+	for _, c := range cols {
+		byName[c.name] = c
+	}
+	fields := make([]*column, len(header))
+	for i, name := range header {
+		if c, ok := byName[name]; ok {
+			fields[i] = &c
+		}
+	}
+
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var item T
+		rv := reflect.ValueOf(&item).Elem()
+		for i, s := range record {
+			if i >= len(fields) || fields[i] == nil {
+				continue
+			}
+			if err := parseValue(rv.Field(fields[i].index), s); err != nil {
+				return fmt.Errorf("csvx: line %d, column %q: %w", line, fields[i].name, err)
+			}
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+}
+
+var textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+
+// formatValue renders a field value as a CSV cell.
+func formatValue(v reflect.Value) (string, error) {
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return "", nil
+	}
+	if v.Type().Implements(textMarshalerType) {
+		b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	case reflect.Pointer:
+		return formatValue(v.Elem())
+	}
+	return "", fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// parseValue parses a CSV cell into the addressable field value v.
+// An empty cell leaves non-string fields at their zero value.
+func parseValue(v reflect.Value, s string) error {
+	if s == "" && v.Kind() != reflect.String {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Pointer:
+		ptr := reflect.New(v.Type().Elem())
+		if err := parseValue(ptr.Elem(), s); err != nil {
+			return err
+		}
+		v.Set(ptr)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
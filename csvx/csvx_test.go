@@ -0,0 +1,123 @@
+package csvx
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type row struct {
+	ID      int        `csv:"id"`
+	Name    string     `csv:"name"`
+	Score   float64    `csv:"score"`
+	Active  bool       `csv:"active"`
+	Joined  *time.Time `csv:"joined"`
+	Secret  string     `csv:"-"`
+	Comment string
+}
+
+func TestMarshal(t *testing.T) {
+	joined := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	items := []row{
+		{ID: 1, Name: "alice", Score: 9.5, Active: true, Joined: &joined, Secret: "x"},
+		{ID: 2, Name: "bob, jr", Comment: "hi"},
+	}
+	got, err := Marshal(items)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "id,name,score,active,joined,Comment\n" +
+		"1,alice,9.5,true,2024-01-02T00:00:00Z,\n" +
+		"2,\"bob, jr\",0,false,,hi\n"
+	if string(got) != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	if _, err := Marshal([]int{1}); err == nil {
+		t.Error("Expected error for non-struct element type")
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	data := "name,id,extra,joined,active\n" +
+		"alice,1,ignored,2024-01-02T00:00:00Z,true\n" +
+		"bob,2,,,\n"
+	got, err := Unmarshal[row](strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	joined := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	expected := []row{
+		{ID: 1, Name: "alice", Active: true, Joined: &joined},
+		{ID: 2, Name: "bob"},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestUnmarshal_RoundTrip(t *testing.T) {
+	items := []row{{ID: 1, Name: "a"}, {ID: 2, Name: "b", Score: 0.25}}
+	data, err := Marshal(items)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got, err := Unmarshal[row](strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, items) {
+		t.Errorf("Expected %+v, got %+v", items, got)
+	}
+}
+
+func TestUnmarshal_InvalidValue(t *testing.T) {
+	_, err := Unmarshal[row](strings.NewReader("id\nabc\n"))
+	if err == nil || !strings.Contains(err.Error(), `line 2, column "id"`) {
+		t.Errorf("Expected parse error with location, got %v", err)
+	}
+}
+
+func TestDecodeEach(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("id\n")
+	for i := 1; i <= 10; i++ {
+		b.WriteString(strconv.Itoa(i) + "\n")
+	}
+
+	var (
+		mu    sync.Mutex
+		total int
+		sizes []int
+	)
+	err := DecodeEach(strings.NewReader(b.String()), 3, 2, func(chunk []row) error {
+		mu.Lock()
+		defer mu.Unlock()
+		total += len(chunk)
+		sizes = append(sizes, len(chunk))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if total != 10 {
+		t.Errorf("Expected 10 rows, got %d", total)
+	}
+	for _, s := range sizes {
+		if s > 3 {
+			t.Errorf("Expected chunks of at most 3 rows, got %d", s)
+		}
+	}
+
+	errStop := errors.New("stop")
+	err = DecodeEach(strings.NewReader(b.String()), 2, 1, func(chunk []row) error {
+		return errStop
+	})
+	if !errors.Is(err, errStop) {
+		t.Errorf("Expected %v, got %v", errStop, err)
+	}
+}
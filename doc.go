@@ -7,4 +7,23 @@
 // Subpackages:
 //   - slice: Utilities for slice manipulation (Collect, Filter, Map, Reduce, Chunk, Flatten, etc.)
 //   - record: Utilities for map/record manipulation (Keys, Values, Clone, Merge, etc.)
+//   - csvx: CSV encoding and decoding for slices of structs (Marshal, Unmarshal, DecodeEach)
+//   - jsonl: Streaming JSON Lines decoding and encoding (Decode, Encode)
+//   - trie: Generic prefix tree keyed by strings (Insert, Get, LongestPrefix, WalkPrefix)
+//   - graph: Dependency graph utilities (TopoSort, Reachable)
+//   - lazy: Memoized values and lazy generators (New, Iterate, Repeat, Take)
+//   - probset: Probabilistic sets (Bloom filter)
+//   - pubsub: In-process publish/subscribe (Topic, Bus)
+//   - immutable: Persistent collections with structural sharing (List, Map)
+//   - sched: Periodic job runner with jitter, overlap prevention and hooks (Every, ChunkJob)
+//   - stream: Lazy line and record iteration over io.Reader (Lines, Split, Filter, Map, Chunk)
+//   - hashx: Deterministic hashes of slices and maps (HashSlice, HashMap)
+//   - cond: Expression-style value selection (If, Switch, Coalesce)
+//   - flight: Generic single-flight call deduplication (Group.Do, Group.DoContext)
+//   - gen: Seedable random data generators for property tests (SliceOf, MapOf, Struct)
+//   - container: Generic fixed-size containers (Ring, SyncRing)
+//   - stats: Numeric summaries (Histogram, AutoBuckets)
+//   - bitset: Compact sets of non-negative integers (BitSet)
+//   - async: Structured concurrency with typed results (Group)
+//   - cache: Size-bounded cache with LRU, LFU and FIFO eviction (New, Stats)
 package devutil
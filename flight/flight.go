@@ -0,0 +1,147 @@
+// Package flight deduplicates concurrent calls for the same key.
+package flight
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cirius-go/devutil/internal/panicerr"
+)
+
+// PanicError represents a panic recovered from a function run by a Group. Its Index is -1.
+// It is the same type as the PanicError of the slice, record, async and sched packages.
+type PanicError = panicerr.Error
+
+// Group runs at most one function per key at a time; callers that arrive while a
+// call is in flight wait for it and share its result.
+// The zero value is ready to use. A Group must not be copied after first use.
+type Group[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*call[V]
+}
+
+// call is an in-flight or completed function call.
+type call[V any] struct {
+	done    chan struct{}
+	value   V
+	err     error
+	dups    int
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// Do runs fn for key, unless a call for key is already in flight, in which case it
+// waits for that call and returns its result. shared reports whether the result
+// was given to more than one caller.
+// If fn panics, the panic propagates to the caller that ran it, and the other
+// callers receive a *PanicError.
+// A Do caller that joins a call started by DoContext keeps that call from being
+// cancelled until it completes.
+func (g *Group[K, V]) Do(key K, fn func() (V, error)) (value V, err error, shared bool) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[K]*call[V])
+	}
+	if c, ok := g.calls[key]; ok {
+		c.dups++
+		// A Do caller never gives up, so a call started by DoContext must not be
+		// cancelled while it waits.
+		c.waiters++
+		g.mu.Unlock()
+		<-c.done
+		return c.value, c.err, true
+	}
+	c := &call[V]{done: make(chan struct{})}
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	completed := false
+	defer func() {
+		if !completed {
+			r := recover()
+			c.err = panicerr.New("flight", -1, r)
+			g.finish(key, c)
+			panic(r)
+		}
+	}()
+	c.value, c.err = fn()
+	completed = true
+	g.finish(key, c)
+	return c.value, c.err, g.shared(c)
+}
+
+// DoContext is like Do, but each caller may give up when its ctx is done, in which
+// case it returns ctx.Err(). fn runs in its own goroutine with a context that keeps
+// the first caller's values and is cancelled only once every waiting caller has given up;
+// a later call for the same key then runs fn again.
+// A panic in fn is returned to every caller as a *PanicError.
+func (g *Group[K, V]) DoContext(ctx context.Context, key K, fn func(ctx context.Context) (V, error)) (value V, err error, shared bool) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[K]*call[V])
+	}
+	c, ok := g.calls[key]
+	if ok {
+		c.dups++
+	} else {
+		fnCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		c = &call[V]{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = c
+		go func() {
+			defer cancel()
+			defer g.finish(key, c)
+			defer func() {
+				if r := recover(); r != nil {
+					c.err = panicerr.New("flight", -1, r)
+				}
+			}()
+			c.value, c.err = fn(fnCtx)
+		}()
+	}
+	c.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-c.done:
+		return c.value, c.err, g.shared(c)
+	case <-ctx.Done():
+		g.mu.Lock()
+		c.waiters--
+		if c.waiters == 0 && c.cancel != nil {
+			// Nobody is waiting any more: cancel fn and let the next caller start afresh
+			// rather than join a call whose context is already cancelled.
+			c.cancel()
+			if g.calls[key] == c {
+				delete(g.calls, key)
+			}
+		}
+		g.mu.Unlock()
+		var zero V
+		return zero, ctx.Err(), false
+	}
+}
+
+// Forget makes the next call for key run its function even if a call is in flight.
+// Callers already waiting on the in-flight call still receive its result.
+func (g *Group[K, V]) Forget(key K) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.calls, key)
+}
+
+// finish removes c from the group and releases its waiters.
+func (g *Group[K, V]) finish(key K, c *call[V]) {
+	g.mu.Lock()
+	if g.calls[key] == c {
+		delete(g.calls, key)
+	}
+	g.mu.Unlock()
+	close(c.done)
+}
+
+// shared reports whether more than one caller received c's result.
+func (g *Group[K, V]) shared(c *call[V]) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return c.dups > 0
+}
@@ -0,0 +1,258 @@
+package flight_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cirius-go/devutil/flight"
+)
+
+func TestGroup_Do(t *testing.T) {
+	var g flight.Group[string, int]
+	var calls atomic.Int32
+	release := make(chan struct{})
+
+	var wg sync.WaitGroup
+	var sharedCount atomic.Int32
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err, shared := g.Do("k", func() (int, error) {
+				calls.Add(1)
+				<-release
+				return 42, nil
+			})
+			if v != 42 || err != nil {
+				t.Errorf("Do() = %v, %v", v, err)
+			}
+			if shared {
+				sharedCount.Add(1)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 call, got %d", calls.Load())
+	}
+	if sharedCount.Load() != 5 {
+		t.Errorf("expected all 5 results to be shared, got %d", sharedCount.Load())
+	}
+
+	// Completed calls are not cached.
+	v, _, shared := g.Do("k", func() (int, error) { return 7, nil })
+	if v != 7 || shared {
+		t.Errorf("Do() after completion = %v, shared %v", v, shared)
+	}
+}
+
+func TestGroup_DoError(t *testing.T) {
+	var g flight.Group[int, string]
+	errLoad := errors.New("load failed")
+	if _, err, _ := g.Do(1, func() (string, error) { return "", errLoad }); !errors.Is(err, errLoad) {
+		t.Errorf("expected errLoad, got %v", err)
+	}
+}
+
+func TestGroup_DoPanic(t *testing.T) {
+	var g flight.Group[string, int]
+	started := make(chan struct{})
+
+	var waiterErr error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		<-started
+		_, waiterErr, _ = g.Do("k", func() (int, error) { return 0, nil })
+	}()
+
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("expected panic to propagate, got %v", r)
+			}
+		}()
+		_, _, _ = g.Do("k", func() (int, error) {
+			close(started)
+			time.Sleep(10 * time.Millisecond)
+			panic("boom")
+		})
+	}()
+	// The waiter may or may not have joined before the panic; if it did, it sees a PanicError.
+	<-done
+	var panicErr *flight.PanicError
+	if waiterErr != nil && !errors.As(waiterErr, &panicErr) {
+		t.Errorf("expected PanicError, got %v", waiterErr)
+	}
+}
+
+func TestGroup_DoContext(t *testing.T) {
+	var g flight.Group[string, int]
+	var calls atomic.Int32
+	release := make(chan struct{})
+	fn := func(ctx context.Context) (int, error) {
+		calls.Add(1)
+		select {
+		case <-release:
+			return 1, nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+
+	// One caller gives up; the other still gets the result.
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 1)
+	go func() {
+		_, err, _ := g.DoContext(ctx, "k", fn)
+		errc <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	resc := make(chan int, 1)
+	go func() {
+		v, _, _ := g.DoContext(context.Background(), "k", fn)
+		resc <- v
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	cancel()
+	if err := <-errc; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	close(release)
+	if v := <-resc; v != 1 {
+		t.Errorf("expected 1, got %v", v)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestGroup_DoContextCancelsWhenAbandoned(t *testing.T) {
+	var g flight.Group[string, int]
+	cancelled := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	_, err, _ := g.DoContext(ctx, "k", func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		close(cancelled)
+		return 0, ctx.Err()
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("expected fn context to be cancelled once every caller gave up")
+	}
+}
+
+func TestGroup_DoContextRestartsAfterAbandoned(t *testing.T) {
+	var g flight.Group[string, int]
+	release := make(chan struct{})
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err, _ := g.DoContext(ctx, "k", func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		<-release
+		return 0, ctx.Err()
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	v, err, _ := g.DoContext(context.Background(), "k", func(context.Context) (int, error) {
+		return 42, nil
+	})
+	if err != nil || v != 42 {
+		t.Errorf("expected a fresh call returning 42, got %d, %v", v, err)
+	}
+}
+
+func TestGroup_DoJoinsDoContext(t *testing.T) {
+	var g flight.Group[string, int]
+	started := make(chan struct{})
+	release := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ctxDone := make(chan error)
+	go func() {
+		_, err, _ := g.DoContext(ctx, "k", func(ctx context.Context) (int, error) {
+			close(started)
+			select {
+			case <-release:
+				return 42, nil
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			}
+		})
+		ctxDone <- err
+	}()
+	<-started
+
+	doDone := make(chan struct{})
+	var (
+		v   int
+		err error
+	)
+	go func() {
+		defer close(doDone)
+		v, err, _ = g.Do("k", func() (int, error) { return -1, nil })
+	}()
+	// Let Do join the in-flight call, then abandon the only DoContext caller.
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	if err := <-ctxDone; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled for the DoContext caller, got %v", err)
+	}
+	close(release)
+	<-doDone
+	if err != nil || v != 42 {
+		t.Errorf("expected the Do caller to get 42, got %d, %v", v, err)
+	}
+}
+
+func TestGroup_DoContextPanic(t *testing.T) {
+	var g flight.Group[string, int]
+	_, err, _ := g.DoContext(context.Background(), "k", func(context.Context) (int, error) {
+		panic(errors.New("boom"))
+	})
+	var panicErr *flight.PanicError
+	if !errors.As(err, &panicErr) || panicErr.Error() != "panic in flight: boom" {
+		t.Errorf("expected PanicError, got %v", err)
+	}
+}
+
+func TestGroup_Forget(t *testing.T) {
+	var g flight.Group[string, int]
+	release := make(chan struct{})
+	go func() {
+		_, _, _ = g.Do("k", func() (int, error) {
+			<-release
+			return 1, nil
+		})
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	g.Forget("k")
+	v, _, shared := g.Do("k", func() (int, error) { return 2, nil })
+	if v != 2 || shared {
+		t.Errorf("expected a fresh call after Forget, got %v, shared %v", v, shared)
+	}
+	close(release)
+}
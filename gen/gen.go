@@ -0,0 +1,196 @@
+// Package gen generates random values for property-style tests.
+package gen
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+)
+
+// Gen produces a random value of type T from r.
+// Generators are deterministic: the same sequence of r yields the same values.
+type Gen[T any] func(r *rand.Rand) T
+
+// NewRand returns a random source seeded with seed, so a failing case can be reproduced.
+func NewRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15))
+}
+
+// Samples returns n values produced by g from a source seeded with seed.
+func Samples[T any](g Gen[T], n int, seed uint64) []T {
+	r := NewRand(seed)
+	samples := make([]T, max(n, 0))
+	for i := range samples {
+		samples[i] = g(r)
+	}
+	return samples
+}
+
+// Const returns a generator that always produces value.
+func Const[T any](value T) Gen[T] {
+	return func(*rand.Rand) T { return value }
+}
+
+// OneOf returns a generator that picks one of values uniformly. It panics if values is empty.
+func OneOf[T any](values ...T) Gen[T] {
+	if len(values) == 0 {
+		panic("gen: OneOf requires at least one value")
+	}
+	return func(r *rand.Rand) T { return values[r.IntN(len(values))] }
+}
+
+// IntRange returns a generator of ints in [lo, hi]. It panics if lo > hi.
+func IntRange(lo, hi int) Gen[int] {
+	if lo > hi {
+		panic("gen: IntRange requires lo <= hi")
+	}
+	// Work in uint64 so that spans wider than MaxInt, such as [MinInt, MaxInt], do not overflow.
+	span := uint64(hi) - uint64(lo)
+	return func(r *rand.Rand) int {
+		if span == math.MaxUint64 {
+			return lo + int(r.Uint64())
+		}
+		return lo + int(r.Uint64N(span+1))
+	}
+}
+
+// Float64Range returns a generator of float64 values in [lo, hi).
+func Float64Range(lo, hi float64) Gen[float64] {
+	return func(r *rand.Rand) float64 { return lo + r.Float64()*(hi-lo) }
+}
+
+// Bool returns a generator of true and false with equal probability.
+func Bool() Gen[bool] {
+	return func(r *rand.Rand) bool { return r.IntN(2) == 1 }
+}
+
+const alphanumeric = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// String returns a generator of alphanumeric strings with lengths in [0, maxLen].
+func String(maxLen int) Gen[string] {
+	return StringFrom(alphanumeric, 0, maxLen)
+}
+
+// StringFrom returns a generator of strings built from the runes of alphabet, with
+// lengths in [minLen, maxLen].
+func StringFrom(alphabet string, minLen, maxLen int) Gen[string] {
+	runes := []rune(alphabet)
+	length := IntRange(max(minLen, 0), max(minLen, maxLen, 0))
+	return func(r *rand.Rand) string {
+		n := length(r)
+		if len(runes) == 0 {
+			return ""
+		}
+		out := make([]rune, n)
+		for i := range out {
+			out[i] = runes[r.IntN(len(runes))]
+		}
+		return string(out)
+	}
+}
+
+// SliceOf returns a generator of slices of exactly n elements produced by elem.
+func SliceOf[T any](elem Gen[T], n int) Gen[[]T] {
+	return func(r *rand.Rand) []T {
+		out := make([]T, max(n, 0))
+		for i := range out {
+			out[i] = elem(r)
+		}
+		return out
+	}
+}
+
+// SliceUpTo returns a generator of slices with lengths in [0, maxLen].
+func SliceUpTo[T any](elem Gen[T], maxLen int) Gen[[]T] {
+	length := IntRange(0, max(maxLen, 0))
+	return func(r *rand.Rand) []T {
+		return SliceOf(elem, length(r))(r)
+	}
+}
+
+// MapOf returns a generator of maps with up to n entries. Keys that collide are
+// regenerated a bounded number of times, so small key spaces yield smaller maps.
+func MapOf[K comparable, V any](key Gen[K], value Gen[V], n int) Gen[map[K]V] {
+	return func(r *rand.Rand) map[K]V {
+		out := make(map[K]V, max(n, 0))
+		for attempts := 0; len(out) < n && attempts < n*10; attempts++ {
+			out[key(r)] = value(r)
+		}
+		return out
+	}
+}
+
+// Map returns a generator that applies fn to the values produced by g.
+func Map[T, U any](g Gen[T], fn func(T) U) Gen[U] {
+	return func(r *rand.Rand) U { return fn(g(r)) }
+}
+
+// maxDepth bounds recursion when generating nested or self-referencing types.
+const maxDepth = 4
+
+// Struct returns a generator that fills every exported field of T with a random value,
+// using reflection. Supported kinds are booleans, integers, floats, strings, slices,
+// arrays, maps, pointers and nested structs; other fields are left at their zero value.
+// Collections have up to 5 elements, and nesting is cut off after a few levels.
+func Struct[T any]() Gen[T] {
+	return func(r *rand.Rand) T {
+		var v T
+		fill(r, reflect.ValueOf(&v).Elem(), 0)
+		return v
+	}
+}
+
+// fill sets v to a random value of its type.
+func fill(r *rand.Rand, v reflect.Value, depth int) {
+	if depth > maxDepth {
+		return
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(r.IntN(2) == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bits := v.Type().Bits()
+		v.SetInt(int64(r.Uint64()) >> (64 - bits)) // arithmetic shift keeps the sign bit
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		bits := v.Type().Bits()
+		v.SetUint(r.Uint64() >> (64 - bits))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(r.NormFloat64() * 1000)
+	case reflect.String:
+		v.SetString(String(12)(r))
+	case reflect.Slice:
+		n := r.IntN(6)
+		s := reflect.MakeSlice(v.Type(), n, n)
+		for i := range n {
+			fill(r, s.Index(i), depth+1)
+		}
+		v.Set(s)
+	case reflect.Array:
+		for i := range v.Len() {
+			fill(r, v.Index(i), depth+1)
+		}
+	case reflect.Map:
+		m := reflect.MakeMap(v.Type())
+		for range r.IntN(6) {
+			k := reflect.New(v.Type().Key()).Elem()
+			e := reflect.New(v.Type().Elem()).Elem()
+			fill(r, k, depth+1)
+			fill(r, e, depth+1)
+			m.SetMapIndex(k, e)
+		}
+		v.Set(m)
+	case reflect.Pointer:
+		if depth == maxDepth || r.IntN(4) == 0 {
+			return // leave some pointers nil
+		}
+		p := reflect.New(v.Type().Elem())
+		fill(r, p.Elem(), depth+1)
+		v.Set(p)
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() {
+				fill(r, v.Field(i), depth+1)
+			}
+		}
+	}
+}
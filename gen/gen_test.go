@@ -0,0 +1,172 @@
+package gen_test
+
+import (
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/cirius-go/devutil/gen"
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestDeterminism(t *testing.T) {
+	g := gen.SliceOf(gen.IntRange(0, 100), 20)
+	a := g(gen.NewRand(42))
+	b := g(gen.NewRand(42))
+	c := g(gen.NewRand(43))
+	if !slices.Equal(a, b) {
+		t.Error("expected the same seed to produce the same slice")
+	}
+	if slices.Equal(a, c) {
+		t.Error("expected different seeds to produce different slices")
+	}
+}
+
+func TestRanges(t *testing.T) {
+	for _, v := range gen.Samples(gen.IntRange(-3, 3), 500, 1) {
+		if v < -3 || v > 3 {
+			t.Fatalf("IntRange produced %d", v)
+		}
+	}
+	for _, v := range gen.Samples(gen.Float64Range(1, 2), 500, 1) {
+		if v < 1 || v >= 2 {
+			t.Fatalf("Float64Range produced %v", v)
+		}
+	}
+	seen := map[int]bool{}
+	for _, v := range gen.Samples(gen.IntRange(5, 5), 10, 1) {
+		seen[v] = true
+	}
+	if len(seen) != 1 || !seen[5] {
+		t.Errorf("IntRange(5, 5) produced %v", seen)
+	}
+}
+
+func TestIntRange_Extremes(t *testing.T) {
+	tests := []struct {
+		name   string
+		lo, hi int
+	}{
+		{"full range", math.MinInt, math.MaxInt},
+		{"negative half", math.MinInt, 0},
+		{"positive half", -1, math.MaxInt},
+		{"near max", math.MaxInt - 2, math.MaxInt},
+		{"near min", math.MinInt, math.MinInt + 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range gen.Samples(gen.IntRange(tt.lo, tt.hi), 500, 3) {
+				if v < tt.lo || v > tt.hi {
+					t.Fatalf("IntRange(%d, %d) produced %d", tt.lo, tt.hi, v)
+				}
+			}
+		})
+	}
+}
+
+func TestStrings(t *testing.T) {
+	for _, s := range gen.Samples(gen.StringFrom("ab€", 2, 4), 200, 7) {
+		n := utf8.RuneCountInString(s)
+		if n < 2 || n > 4 {
+			t.Fatalf("StringFrom produced %q", s)
+		}
+		for _, r := range s {
+			if r != 'a' && r != 'b' && r != '€' {
+				t.Fatalf("StringFrom produced rune %q", r)
+			}
+		}
+	}
+	for _, s := range gen.Samples(gen.String(3), 100, 7) {
+		if len(s) > 3 {
+			t.Fatalf("String produced %q", s)
+		}
+	}
+}
+
+func TestCollections(t *testing.T) {
+	r := gen.NewRand(3)
+	if s := gen.SliceUpTo(gen.Bool(), 4)(r); len(s) > 4 {
+		t.Errorf("SliceUpTo produced %d elements", len(s))
+	}
+
+	m := gen.MapOf(gen.IntRange(0, 1000), gen.String(5), 10)(r)
+	if len(m) != 10 {
+		t.Errorf("MapOf produced %d entries, want 10", len(m))
+	}
+	small := gen.MapOf(gen.OneOf("x", "y"), gen.Const(1), 10)(r)
+	if len(small) > 2 {
+		t.Errorf("MapOf with two keys produced %v", small)
+	}
+
+	doubled := gen.Map(gen.IntRange(1, 10), func(v int) int { return v * 2 })
+	for _, v := range gen.Samples(doubled, 50, 1) {
+		if v%2 != 0 {
+			t.Fatalf("Map produced %d", v)
+		}
+	}
+}
+
+func TestStruct(t *testing.T) {
+	type address struct {
+		City string
+		Zip  uint16
+	}
+	type person struct {
+		Name    string
+		Age     int8
+		Score   float64
+		Active  bool
+		Tags    []string
+		Attrs   map[string]int
+		Home    *address
+		Work    address
+		private int
+	}
+
+	g := gen.Struct[person]()
+	a := g(gen.NewRand(9))
+	if !reflect.DeepEqual(a, g(gen.NewRand(9))) {
+		t.Error("expected Struct to be deterministic")
+	}
+
+	people := gen.Samples(g, 50, 9)
+	if slice.Every(people, func(p person) bool { return p.Name == "" }) {
+		t.Error("expected names to be filled")
+	}
+	if !slice.Some(people, func(p person) bool { return p.Home != nil && len(p.Tags) > 0 }) {
+		t.Error("expected nested pointers and slices to be filled")
+	}
+	if !slice.Every(people, func(p person) bool { return p.private == 0 }) {
+		t.Error("expected unexported fields to be left alone")
+	}
+
+	type signed struct {
+		I   int
+		I8  int8
+		I64 int64
+	}
+	values := gen.Samples(gen.Struct[signed](), 200, 9)
+	if !slice.Some(values, func(v signed) bool { return v.I < 0 }) ||
+		!slice.Some(values, func(v signed) bool { return v.I8 < 0 }) ||
+		!slice.Some(values, func(v signed) bool { return v.I64 < 0 }) {
+		t.Error("expected signed fields to take negative values")
+	}
+	if !slice.Some(values, func(v signed) bool { return v.I8 > 0 }) {
+		t.Error("expected signed fields to take positive values")
+	}
+}
+
+func TestSortProperty(t *testing.T) {
+	// Example property: SortChunked agrees with slices.Sort for any input.
+	inputs := gen.Samples(gen.SliceUpTo(gen.IntRange(-50, 50), 200), 50, 1)
+	for _, in := range inputs {
+		got := slice.SortChunked(in, func(a, b int) bool { return a < b }, 16, 4)
+		want := slices.Clone(in)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Fatalf("SortChunked(%v) = %v", in, got)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// Package graph provides dependency graph utilities over adjacency maps.
+package graph
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cirius-go/devutil/record"
+	"github.com/cirius-go/devutil/slice"
+)
+
+// CycleError is returned by TopoSort when the graph contains a cycle.
+type CycleError[K cmp.Ordered] struct {
+	// Cycle lists the nodes of one cycle in order, starting and ending with the same node.
+	Cycle []K
+}
+
+// Error implements the error interface for CycleError.
+func (e *CycleError[K]) Error() string {
+	parts := make([]string, len(e.Cycle))
+	for i, n := range e.Cycle {
+		parts[i] = fmt.Sprint(n)
+	}
+	return "graph: dependency cycle: " + strings.Join(parts, " -> ")
+}
+
+// TopoSort orders the nodes of a dependency graph so that every node comes after
+// the nodes it depends on. deps maps each node to its dependencies; nodes that only
+// appear as dependencies are included too.
+// Among nodes whose dependencies are satisfied, the smallest is emitted first, so the
+// result is deterministic. If the graph has a cycle, it returns a *CycleError.
+func TopoSort[K cmp.Ordered](deps map[K][]K) ([]K, error) {
+	var (
+		pending    = make(map[K]int) // number of unresolved dependencies per node
+		dependents = make(map[K][]K) // reverse edges
+	)
+	for n, ds := range deps {
+		if _, ok := pending[n]; !ok {
+			pending[n] = 0
+		}
+		for _, d := range record.Keys(record.ToSet(ds)) {
+			if _, ok := pending[d]; !ok {
+				pending[d] = 0
+			}
+			pending[n]++
+			dependents[d] = append(dependents[d], n)
+		}
+	}
+
+	less := func(a, b K) bool { return a < b }
+	ready := record.KeysWhere(pending, func(_ K, count int) bool { return count == 0 })
+	slices.Sort(ready)
+
+	order := make([]K, 0, len(pending))
+	for len(ready) > 0 {
+		n := ready[0]
+		ready = ready[1:]
+		order = append(order, n)
+		for _, d := range dependents[n] {
+			pending[d]--
+			if pending[d] == 0 {
+				ready = slice.InsertSorted(ready, d, less)
+			}
+		}
+	}
+
+	if len(order) < len(pending) {
+		remaining := record.KeysWhere(pending, func(_ K, count int) bool { return count > 0 })
+		return nil, &CycleError[K]{Cycle: findCycle(deps, remaining)}
+	}
+	return order, nil
+}
+
+// findCycle returns one cycle among the given nodes, which are known to contain one.
+func findCycle[K cmp.Ordered](deps map[K][]K, nodes []K) []K {
+	slices.Sort(nodes)
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	var (
+		state = make(map[K]int, len(nodes))
+		stack []K
+		visit func(n K) []K
+	)
+	visit = func(n K) []K {
+		state[n] = visiting
+		stack = append(stack, n)
+		next := slices.Clone(deps[n])
+		slices.Sort(next)
+		for _, d := range next {
+			switch state[d] {
+			case visiting:
+				start := slices.Index(stack, d)
+				return append(slices.Clone(stack[start:]), d)
+			case unvisited:
+				if cycle := visit(d); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[n] = done
+		return nil
+	}
+	for _, n := range nodes {
+		if state[n] == unvisited {
+			if cycle := visit(n); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nodes
+}
+
+// Reachable returns every node reachable from the given node by following edges,
+// sorted in ascending order. The starting node is only included if it lies on a cycle.
+func Reachable[K cmp.Ordered](edges map[K][]K, from K) []K {
+	var (
+		seen  = make(map[K]struct{})
+		queue = slices.Clone(edges[from])
+	)
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		if _, ok := seen[n]; ok {
+			continue
+		}
+		seen[n] = struct{}{}
+		queue = append(queue, edges[n]...)
+	}
+	return record.SortedKeys(seen)
+}
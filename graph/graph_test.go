@@ -0,0 +1,77 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTopoSort(t *testing.T) {
+	deps := map[string][]string{
+		"app":    {"db", "cache", "config"},
+		"db":     {"config"},
+		"cache":  {"config"},
+		"worker": {"db", "db"},
+	}
+	order, err := TopoSort(deps)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []string{"config", "cache", "db", "app", "worker"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("Expected %v, got %v", expected, order)
+	}
+
+	empty, err := TopoSort(map[int][]int{})
+	if err != nil || len(empty) != 0 {
+		t.Errorf("Expected empty order for empty graph, got %v, %v", empty, err)
+	}
+}
+
+func TestTopoSort_Cycle(t *testing.T) {
+	deps := map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"a"},
+		"d": {"a"},
+		"e": {},
+	}
+	_, err := TopoSort(deps)
+	var cycleErr *CycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("Expected CycleError, got %v", err)
+	}
+	expected := []string{"a", "b", "c", "a"}
+	if !reflect.DeepEqual(cycleErr.Cycle, expected) {
+		t.Errorf("Expected cycle %v, got %v", expected, cycleErr.Cycle)
+	}
+	if err.Error() != "graph: dependency cycle: a -> b -> c -> a" {
+		t.Errorf("Unexpected error message: %q", err.Error())
+	}
+
+	_, err = TopoSort(map[int][]int{1: {1}})
+	if !errors.As(err, new(*CycleError[int])) {
+		t.Errorf("Expected self-loop to be reported as a cycle, got %v", err)
+	}
+}
+
+func TestReachable(t *testing.T) {
+	edges := map[int][]int{
+		1: {2, 3},
+		2: {4},
+		3: {4},
+		4: {},
+		5: {1},
+	}
+	if got := Reachable(edges, 1); !reflect.DeepEqual(got, []int{2, 3, 4}) {
+		t.Errorf("Expected [2 3 4], got %v", got)
+	}
+	if got := Reachable(edges, 4); len(got) != 0 {
+		t.Errorf("Expected no reachable nodes, got %v", got)
+	}
+
+	cyclic := map[string][]string{"a": {"b"}, "b": {"a"}}
+	if got := Reachable(cyclic, "a"); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("Expected [a b], got %v", got)
+	}
+}
@@ -0,0 +1,94 @@
+// Package hashx computes deterministic hashes of slices and maps.
+package hashx
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+)
+
+const (
+	fnvOffset = 14695981039346656037
+	fnvPrime  = 1099511628211
+)
+
+// Hasher returns a deterministic hash of a single value.
+type Hasher[T any] func(item T) uint64
+
+// EntryHasher returns a deterministic hash of a single map entry.
+type EntryHasher[K comparable, V any] func(key K, value V) uint64
+
+// String returns the 64-bit FNV-1a hash of s.
+func String(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return h.Sum64()
+}
+
+// Bytes returns the 64-bit FNV-1a hash of b.
+func Bytes(b []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64()
+}
+
+// Uint64 returns a well-mixed hash of v.
+func Uint64(v uint64) uint64 {
+	return mix(v)
+}
+
+// Sprint hashes the fmt.Sprint representation of v. It works for any type,
+// including maps (fmt prints map keys in sorted order), but is slower than a
+// type-specific Hasher.
+func Sprint[T any](v T) uint64 {
+	return String(fmt.Sprint(v))
+}
+
+// HashSlice returns a hash of the slice that depends on the elements and their order.
+// If hasher is nil, Sprint is used. The result is stable across processes.
+func HashSlice[T any](input []T, hasher Hasher[T]) uint64 {
+	if hasher == nil {
+		hasher = Sprint[T]
+	}
+	h := combine(fnvOffset, uint64(len(input)))
+	for _, item := range input {
+		h = combine(h, hasher(item))
+	}
+	return h
+}
+
+// HashMap returns a hash of the map that depends on its entries but not on iteration order.
+// If hasher is nil, the key and value are hashed with Sprint. The result is stable across processes.
+func HashMap[K comparable, V any](m map[K]V, hasher EntryHasher[K, V]) uint64 {
+	if hasher == nil {
+		hasher = func(k K, v V) uint64 {
+			return combine(Sprint(k), Sprint(v))
+		}
+	}
+	var sum uint64
+	for k, v := range m {
+		// Addition is commutative, so the result does not depend on iteration order.
+		sum += mix(hasher(k, v))
+	}
+	return combine(combine(fnvOffset, uint64(len(m))), sum)
+}
+
+// Digest returns h as an 8-byte big-endian digest.
+func Digest(h uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, h)
+}
+
+// combine folds v into the running hash h.
+func combine(h, v uint64) uint64 {
+	return mix((h ^ v) * fnvPrime)
+}
+
+// mix is the SplitMix64 finalizer, spreading input bits over the whole output.
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
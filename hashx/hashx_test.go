@@ -0,0 +1,75 @@
+package hashx_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/cirius-go/devutil/hashx"
+)
+
+func TestString(t *testing.T) {
+	// Known FNV-1a 64 test vectors.
+	if got := hashx.String(""); got != 0xcbf29ce484222325 {
+		t.Errorf("String(\"\") = %x", got)
+	}
+	if got := hashx.String("a"); got != 0xaf63dc4c8601ec8c {
+		t.Errorf("String(\"a\") = %x", got)
+	}
+	if hashx.Bytes([]byte("a")) != hashx.String("a") {
+		t.Error("Bytes and String disagree")
+	}
+}
+
+func TestHashSlice(t *testing.T) {
+	a := hashx.HashSlice([]string{"x", "y"}, hashx.String)
+	b := hashx.HashSlice([]string{"x", "y"}, hashx.String)
+	c := hashx.HashSlice([]string{"y", "x"}, hashx.String)
+
+	if a != b {
+		t.Error("expected equal slices to hash equally")
+	}
+	if a == c {
+		t.Error("expected order to change the hash")
+	}
+	if hashx.HashSlice([]string{}, nil) == hashx.HashSlice([]string{""}, nil) {
+		t.Error("expected length to change the hash")
+	}
+	if hashx.HashSlice([]int{1, 2}, nil) != hashx.HashSlice([]int{1, 2}, nil) {
+		t.Error("expected default hasher to be deterministic")
+	}
+}
+
+func TestHashMap(t *testing.T) {
+	m1 := map[string]int{"a": 1, "b": 2, "c": 3}
+	m2 := map[string]int{"c": 3, "a": 1, "b": 2}
+	m3 := map[string]int{"a": 1, "b": 3, "c": 2}
+
+	h1 := hashx.HashMap(m1, nil)
+	for range 10 {
+		if hashx.HashMap(m2, nil) != h1 {
+			t.Fatal("expected map hash to be independent of iteration order")
+		}
+	}
+	if hashx.HashMap(m3, nil) == h1 {
+		t.Error("expected swapped values to change the hash")
+	}
+	if hashx.HashMap(map[string]int{}, nil) == hashx.HashMap(map[string]int{"": 0}, nil) {
+		t.Error("expected entries to change the hash")
+	}
+
+	custom := func(k string, v int) uint64 { return hashx.String(k) ^ hashx.Uint64(uint64(v)) }
+	if hashx.HashMap(m1, custom) != hashx.HashMap(m2, custom) {
+		t.Error("expected custom hasher to be order-independent")
+	}
+}
+
+func TestDigest(t *testing.T) {
+	d := hashx.Digest(0x0102030405060708)
+	if !bytes.Equal(d, []byte{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("Digest() = %v", d)
+	}
+	if binary.BigEndian.Uint64(d) != 0x0102030405060708 {
+		t.Error("Digest() does not round-trip")
+	}
+}
@@ -0,0 +1,158 @@
+// Package immutable provides persistent collections that share structure between versions,
+// so snapshots can be handed to concurrent readers without cloning.
+package immutable
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+const (
+	bitsPerLevel = 5
+	branching    = 1 << bitsPerLevel
+	levelMask    = branching - 1
+)
+
+// listNode is a node of the List trie. Leaves hold values, inner nodes hold children.
+type listNode[T any] struct {
+	children []*listNode[T]
+	values   []T
+}
+
+// List is a persistent vector. Append and Set return a new List that shares all
+// untouched nodes with the original, which is never modified.
+// A List is safe for concurrent use. The zero value is an empty list.
+type List[T any] struct {
+	root  *listNode[T]
+	size  int
+	shift uint
+}
+
+// NewList creates a List holding the given items.
+func NewList[T any](items ...T) *List[T] {
+	l := &List[T]{}
+	for _, item := range items {
+		l = l.Append(item)
+	}
+	return l
+}
+
+// Len returns the number of elements in the list.
+func (l *List[T]) Len() int {
+	return l.size
+}
+
+// Get returns the element at index i. It panics if i is out of range.
+func (l *List[T]) Get(i int) T {
+	l.checkIndex(i)
+	n := l.root
+	for shift := l.shift; shift > 0; shift -= bitsPerLevel {
+		n = n.children[(i>>shift)&levelMask]
+	}
+	return n.values[i&levelMask]
+}
+
+// Set returns a new list with the element at index i replaced by value.
+// It panics if i is out of range.
+func (l *List[T]) Set(i int, value T) *List[T] {
+	l.checkIndex(i)
+	return &List[T]{
+		root:  setInNode(l.root, l.shift, i, value),
+		size:  l.size,
+		shift: l.shift,
+	}
+}
+
+// Append returns a new list with value added at the end.
+func (l *List[T]) Append(value T) *List[T] {
+	if l.root == nil {
+		return &List[T]{root: &listNode[T]{values: []T{value}}, size: 1}
+	}
+	if l.size == 1<<(l.shift+bitsPerLevel) {
+		// The trie is full: grow a level.
+		root := &listNode[T]{children: []*listNode[T]{l.root, newPath(l.shift, value)}}
+		return &List[T]{root: root, size: l.size + 1, shift: l.shift + bitsPerLevel}
+	}
+	return &List[T]{
+		root:  appendToNode(l.root, l.shift, l.size, value),
+		size:  l.size + 1,
+		shift: l.shift,
+	}
+}
+
+// All returns an iterator over the index and value of every element, in order.
+func (l *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		var walk func(n *listNode[T]) bool
+		walk = func(n *listNode[T]) bool {
+			for _, child := range n.children {
+				if !walk(child) {
+					return false
+				}
+			}
+			for _, v := range n.values {
+				if !yield(i, v) {
+					return false
+				}
+				i++
+			}
+			return true
+		}
+		if l.root != nil {
+			walk(l.root)
+		}
+	}
+}
+
+// ToSlice copies the elements of the list into a new slice.
+func (l *List[T]) ToSlice() []T {
+	result := make([]T, 0, l.size)
+	for _, v := range l.All() {
+		result = append(result, v)
+	}
+	return result
+}
+
+// checkIndex panics if i is not a valid index.
+func (l *List[T]) checkIndex(i int) {
+	if i < 0 || i >= l.size {
+		panic(fmt.Sprintf("immutable: index %d out of range [0:%d]", i, l.size))
+	}
+}
+
+// setInNode returns a copy of the path to index i with the value replaced.
+func setInNode[T any](n *listNode[T], shift uint, i int, value T) *listNode[T] {
+	if shift == 0 {
+		values := slices.Clone(n.values)
+		values[i&levelMask] = value
+		return &listNode[T]{values: values}
+	}
+	children := slices.Clone(n.children)
+	ci := (i >> shift) & levelMask
+	children[ci] = setInNode(children[ci], shift-bitsPerLevel, i, value)
+	return &listNode[T]{children: children}
+}
+
+// appendToNode returns a copy of the path to index i with value appended there.
+func appendToNode[T any](n *listNode[T], shift uint, i int, value T) *listNode[T] {
+	if shift == 0 {
+		return &listNode[T]{values: append(slices.Clip(n.values), value)}
+	}
+	ci := (i >> shift) & levelMask
+	if ci < len(n.children) {
+		children := slices.Clone(n.children)
+		children[ci] = appendToNode(children[ci], shift-bitsPerLevel, i, value)
+		return &listNode[T]{children: children}
+	}
+	return &listNode[T]{children: append(slices.Clip(n.children), newPath(shift-bitsPerLevel, value))}
+}
+
+// newPath builds a fresh branch of the given height holding a single value.
+func newPath[T any](shift uint, value T) *listNode[T] {
+	if shift == 0 {
+		return &listNode[T]{values: []T{value}}
+	}
+	return &listNode[T]{children: []*listNode[T]{newPath(shift-bitsPerLevel, value)}}
+}
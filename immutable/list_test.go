@@ -0,0 +1,82 @@
+package immutable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestList_AppendGet(t *testing.T) {
+	l := NewList[int]()
+	var versions []*List[int]
+	for i := 0; i < 2000; i++ {
+		versions = append(versions, l)
+		l = l.Append(i)
+	}
+	if l.Len() != 2000 {
+		t.Fatalf("Expected length 2000, got %d", l.Len())
+	}
+	for i := 0; i < 2000; i++ {
+		if got := l.Get(i); got != i {
+			t.Fatalf("Get(%d) = %d", i, got)
+		}
+	}
+	// Earlier versions are untouched.
+	for _, n := range []int{0, 1, 32, 33, 1024, 1025} {
+		if versions[n].Len() != n {
+			t.Errorf("version %d has length %d", n, versions[n].Len())
+		}
+	}
+}
+
+func TestList_Set(t *testing.T) {
+	l := NewList(1, 2, 3)
+	l2 := l.Set(1, 20)
+	if !reflect.DeepEqual(l.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("Expected original to be unchanged, got %v", l.ToSlice())
+	}
+	if !reflect.DeepEqual(l2.ToSlice(), []int{1, 20, 3}) {
+		t.Errorf("Expected [1 20 3], got %v", l2.ToSlice())
+	}
+
+	big := NewList[int]()
+	for i := 0; i < 100; i++ {
+		big = big.Append(i)
+	}
+	changed := big.Set(70, -1).Append(100)
+	if big.Get(70) != 70 || changed.Get(70) != -1 || changed.Len() != 101 {
+		t.Error("Expected Set on a deep list to copy only the changed path")
+	}
+}
+
+func TestList_SharedAppend(t *testing.T) {
+	base := NewList(1, 2)
+	a := base.Append(3)
+	b := base.Append(4)
+	if a.Get(2) != 3 || b.Get(2) != 4 {
+		t.Errorf("Expected independent branches, got %v and %v", a.ToSlice(), b.ToSlice())
+	}
+}
+
+func TestList_Panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected Get out of range to panic")
+		}
+	}()
+	var l List[int]
+	l.Get(0)
+}
+
+func TestList_All(t *testing.T) {
+	l := NewList("a", "b", "c")
+	var got []string
+	for i, v := range l.All() {
+		if i == 2 {
+			break
+		}
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("Expected [a b], got %v", got)
+	}
+}
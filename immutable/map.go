@@ -0,0 +1,240 @@
+package immutable
+
+import (
+	"hash/maphash"
+	"iter"
+	"math/bits"
+	"slices"
+)
+
+// mapEntry is a key-value pair stored in a Map.
+type mapEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// mapSlot is either a subtree or a bucket of entries sharing one hash.
+type mapSlot[K comparable, V any] struct {
+	child   *mapNode[K, V]
+	hash    uint64
+	entries []mapEntry[K, V]
+}
+
+// mapNode is a node of the hash array mapped trie: the bitmap records which of the
+// 32 possible slots are present, and slots holds them compactly.
+type mapNode[K comparable, V any] struct {
+	bitmap uint32
+	slots  []mapSlot[K, V]
+}
+
+// Map is a persistent hash map. Set and Delete return a new Map that shares all
+// untouched nodes with the original, which is never modified.
+// A Map is safe for concurrent use.
+type Map[K comparable, V any] struct {
+	root *mapNode[K, V]
+	size int
+	hash func(K) uint64
+}
+
+// NewMap creates an empty Map.
+func NewMap[K comparable, V any]() *Map[K, V] {
+	seed := maphash.MakeSeed()
+	return &Map[K, V]{
+		hash: func(k K) uint64 { return maphash.Comparable(seed, k) },
+	}
+}
+
+// FromMap creates a Map holding the entries of m.
+func FromMap[K comparable, V any](m map[K]V) *Map[K, V] {
+	result := NewMap[K, V]()
+	for k, v := range m {
+		result = result.Set(k, v)
+	}
+	return result
+}
+
+// Len returns the number of entries in the map.
+func (m *Map[K, V]) Len() int {
+	return m.size
+}
+
+// Get returns the value stored for key and true, or the zero value and false if the
+// key is not present.
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	var zero V
+	if m.root == nil {
+		return zero, false
+	}
+	h := m.hash(key)
+	n := m.root
+	for shift := uint(0); ; shift += bitsPerLevel {
+		bit := slotBit(h, shift)
+		if n.bitmap&bit == 0 {
+			return zero, false
+		}
+		s := n.slots[slotPos(n.bitmap, bit)]
+		if s.child != nil {
+			n = s.child
+			continue
+		}
+		if s.hash == h {
+			for _, e := range s.entries {
+				if e.key == key {
+					return e.value, true
+				}
+			}
+		}
+		return zero, false
+	}
+}
+
+// Set returns a new map with value stored under key.
+func (m *Map[K, V]) Set(key K, value V) *Map[K, V] {
+	root, added := setInMap(m.root, 0, m.hash(key), mapEntry[K, V]{key, value})
+	size := m.size
+	if added {
+		size++
+	}
+	return &Map[K, V]{root: root, size: size, hash: m.hash}
+}
+
+// Delete returns a new map without key. If the key is not present, m is returned.
+func (m *Map[K, V]) Delete(key K) *Map[K, V] {
+	if m.root == nil {
+		return m
+	}
+	root, removed := deleteFromMap(m.root, 0, m.hash(key), key)
+	if !removed {
+		return m
+	}
+	return &Map[K, V]{root: root, size: m.size - 1, hash: m.hash}
+}
+
+// All returns an iterator over the entries of the map.
+// The order of entries is not guaranteed.
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var walk func(n *mapNode[K, V]) bool
+		walk = func(n *mapNode[K, V]) bool {
+			for _, s := range n.slots {
+				if s.child != nil {
+					if !walk(s.child) {
+						return false
+					}
+					continue
+				}
+				for _, e := range s.entries {
+					if !yield(e.key, e.value) {
+						return false
+					}
+				}
+			}
+			return true
+		}
+		if m.root != nil {
+			walk(m.root)
+		}
+	}
+}
+
+// ToMap copies the entries of the map into a new built-in map.
+func (m *Map[K, V]) ToMap() map[K]V {
+	result := make(map[K]V, m.size)
+	for k, v := range m.All() {
+		result[k] = v
+	}
+	return result
+}
+
+// slotBit returns the bitmap bit selected by hash h at the given depth.
+func slotBit(h uint64, shift uint) uint32 {
+	return 1 << ((h >> shift) & levelMask)
+}
+
+// slotPos returns the index in slots of the slot marked by bit.
+func slotPos(bitmap, bit uint32) int {
+	return bits.OnesCount32(bitmap & (bit - 1))
+}
+
+// setInMap returns a copy of n with e stored, and whether a new key was added.
+func setInMap[K comparable, V any](n *mapNode[K, V], shift uint, h uint64, e mapEntry[K, V]) (*mapNode[K, V], bool) {
+	if n == nil {
+		n = &mapNode[K, V]{}
+	}
+	bit := slotBit(h, shift)
+	pos := slotPos(n.bitmap, bit)
+	if n.bitmap&bit == 0 {
+		slots := slices.Insert(slices.Clip(n.slots), pos, mapSlot[K, V]{hash: h, entries: []mapEntry[K, V]{e}})
+		return &mapNode[K, V]{bitmap: n.bitmap | bit, slots: slots}, true
+	}
+
+	s := n.slots[pos]
+	var added bool
+	switch {
+	case s.child != nil:
+		s.child, added = setInMap(s.child, shift+bitsPerLevel, h, e)
+	case s.hash == h:
+		i := slices.IndexFunc(s.entries, func(old mapEntry[K, V]) bool { return old.key == e.key })
+		if i >= 0 {
+			s.entries = slices.Clone(s.entries)
+			s.entries[i] = e
+		} else {
+			s.entries = append(slices.Clip(s.entries), e)
+			added = true
+		}
+	default:
+		// Two different hashes share this slot: push both one level down.
+		child := &mapNode[K, V]{bitmap: slotBit(s.hash, shift+bitsPerLevel), slots: []mapSlot[K, V]{s}}
+		s = mapSlot[K, V]{}
+		s.child, added = setInMap(child, shift+bitsPerLevel, h, e)
+	}
+	slots := slices.Clone(n.slots)
+	slots[pos] = s
+	return &mapNode[K, V]{bitmap: n.bitmap, slots: slots}, added
+}
+
+// deleteFromMap returns a copy of n without key, or nil if the node becomes empty,
+// and whether the key was found.
+func deleteFromMap[K comparable, V any](n *mapNode[K, V], shift uint, h uint64, key K) (*mapNode[K, V], bool) {
+	bit := slotBit(h, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	pos := slotPos(n.bitmap, bit)
+	s := n.slots[pos]
+
+	switch {
+	case s.child != nil:
+		child, removed := deleteFromMap(s.child, shift+bitsPerLevel, h, key)
+		if !removed {
+			return n, false
+		}
+		switch {
+		case child == nil:
+			s = mapSlot[K, V]{}
+		case len(child.slots) == 1 && child.slots[0].child == nil:
+			s = child.slots[0] // collapse a lone bucket into this level
+		default:
+			s = mapSlot[K, V]{child: child}
+		}
+	case s.hash == h:
+		i := slices.IndexFunc(s.entries, func(e mapEntry[K, V]) bool { return e.key == key })
+		if i < 0 {
+			return n, false
+		}
+		s.entries = slices.Delete(slices.Clone(s.entries), i, i+1)
+	default:
+		return n, false
+	}
+
+	if s.child == nil && len(s.entries) == 0 {
+		if len(n.slots) == 1 {
+			return nil, true
+		}
+		slots := slices.Delete(slices.Clone(n.slots), pos, pos+1)
+		return &mapNode[K, V]{bitmap: n.bitmap &^ bit, slots: slots}, true
+	}
+	slots := slices.Clone(n.slots)
+	slots[pos] = s
+	return &mapNode[K, V]{bitmap: n.bitmap, slots: slots}, true
+}
@@ -0,0 +1,100 @@
+package immutable
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestMap_Basic(t *testing.T) {
+	m := NewMap[string, int]()
+	m1 := m.Set("a", 1).Set("b", 2)
+	m2 := m1.Set("a", 10).Delete("b")
+
+	if m.Len() != 0 || m1.Len() != 2 || m2.Len() != 1 {
+		t.Errorf("Unexpected lengths %d %d %d", m.Len(), m1.Len(), m2.Len())
+	}
+	if v, ok := m1.Get("a"); !ok || v != 1 {
+		t.Errorf("Expected m1[a]=1, got %d %v", v, ok)
+	}
+	if v, ok := m2.Get("a"); !ok || v != 10 {
+		t.Errorf("Expected m2[a]=10, got %d %v", v, ok)
+	}
+	if _, ok := m2.Get("b"); ok {
+		t.Error("Expected b to be deleted from m2")
+	}
+	if m2.Delete("missing") != m2 {
+		t.Error("Expected Delete of missing key to return the same map")
+	}
+	if !reflect.DeepEqual(m1.ToMap(), map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("Unexpected m1 contents %v", m1.ToMap())
+	}
+}
+
+func TestMap_FromMap(t *testing.T) {
+	src := map[int]string{1: "a", 2: "b", 3: "c"}
+	if got := FromMap(src).ToMap(); !reflect.DeepEqual(got, src) {
+		t.Errorf("Expected %v, got %v", src, got)
+	}
+}
+
+func TestMap_RandomOps(t *testing.T) {
+	hashes := map[string]func(int) uint64{
+		"default":    nil,
+		"collisions": func(k int) uint64 { return uint64(k % 7) },
+		"deep":       func(k int) uint64 { return uint64(k%3) << 62 },
+	}
+	for name, hash := range hashes {
+		t.Run(name, func(t *testing.T) {
+			m := NewMap[int, int]()
+			if hash != nil {
+				m.hash = hash
+			}
+			ref := make(map[int]int)
+			rng := rand.New(rand.NewSource(1))
+
+			for i := 0; i < 5000; i++ {
+				k := rng.Intn(300)
+				if rng.Intn(3) == 0 {
+					m = m.Delete(k)
+					delete(ref, k)
+				} else {
+					m = m.Set(k, i)
+					ref[k] = i
+				}
+			}
+			if m.Len() != len(ref) {
+				t.Fatalf("Expected length %d, got %d", len(ref), m.Len())
+			}
+			for k := 0; k < 300; k++ {
+				want, wantOK := ref[k]
+				got, ok := m.Get(k)
+				if ok != wantOK || got != want {
+					t.Fatalf("Get(%d) = (%d, %v), want (%d, %v)", k, got, ok, want, wantOK)
+				}
+			}
+			if !reflect.DeepEqual(m.ToMap(), ref) {
+				t.Error("ToMap() does not match reference map")
+			}
+		})
+	}
+}
+
+func TestMap_Snapshots(t *testing.T) {
+	base := NewMap[int, int]()
+	for i := 0; i < 100; i++ {
+		base = base.Set(i, i)
+	}
+	snapshot := base
+	for i := 0; i < 100; i++ {
+		base = base.Delete(i)
+	}
+	if snapshot.Len() != 100 || base.Len() != 0 {
+		t.Fatalf("Unexpected lengths %d and %d", snapshot.Len(), base.Len())
+	}
+	for i := 0; i < 100; i++ {
+		if v, ok := snapshot.Get(i); !ok || v != i {
+			t.Fatalf("Snapshot lost key %d", i)
+		}
+	}
+}
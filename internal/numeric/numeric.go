@@ -0,0 +1,10 @@
+// Package numeric defines the Number constraint that slice, record and stats
+// re-export, so generic numeric code can move between them.
+package numeric
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
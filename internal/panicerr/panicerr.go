@@ -0,0 +1,50 @@
+// Package panicerr defines the error reported for panics recovered from user
+// functions. slice, record, async, sched and flight re-export it as PanicError, so a
+// single errors.As target matches panics from any of them.
+package panicerr
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// Error represents a panic recovered from a user function.
+type Error struct {
+	// Index is the position of the panicking function: the element or chunk index in
+	// slice, the submission index in async and the run number in sched.
+	// It is -1 when there is no position, as in flight and record.
+	Index int
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the panicking goroutine.
+	Stack []byte
+
+	op string
+}
+
+// New builds an Error for a panic with value in the function described by op, at
+// index, or -1 for none. It must be called from the deferred function that recovered
+// the panic, so that the captured stack includes the panicking frames.
+func New(op string, index int, value any) *Error {
+	return &Error{Index: index, Value: value, Stack: debug.Stack(), op: op}
+}
+
+// Error implements the error interface for Error.
+func (e *Error) Error() string {
+	op := e.op
+	if op == "" {
+		op = "function"
+	}
+	if e.Index < 0 {
+		return fmt.Sprintf("panic in %s: %v", op, e.Value)
+	}
+	return fmt.Sprintf("panic in %s %d: %v", op, e.Index, e.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (e *Error) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
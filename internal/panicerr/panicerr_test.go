@@ -0,0 +1,44 @@
+package panicerr
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *Error
+		want string
+	}{
+		{name: "indexed", err: New("task", 2, "boom"), want: "panic in task 2: boom"},
+		{name: "unindexed", err: New("flight", -1, "boom"), want: "panic in flight: boom"},
+		{name: "literal", err: &Error{Index: 1, Value: "boom"}, want: "panic in function 1: boom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestError_Unwrap(t *testing.T) {
+	errBoom := errors.New("boom")
+	var err error
+	func() {
+		defer func() { err = New("handler at index", 0, recover()) }()
+		panic(errBoom)
+	}()
+	if !errors.Is(err, errBoom) {
+		t.Errorf("expected the panic value to be unwrapped, got %v", err)
+	}
+	if stack := string(err.(*Error).Stack); !strings.Contains(stack, "TestError_Unwrap") {
+		t.Errorf("expected the stack to include the panicking function, got %s", stack)
+	}
+	if New("task", 0, "boom").Unwrap() != nil {
+		t.Error("expected a non-error panic value not to unwrap")
+	}
+}
@@ -0,0 +1,72 @@
+// Package jsonl streams JSON Lines (newline-delimited JSON) data.
+package jsonl
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// Decode returns an iterator over the JSON values in r, one per line.
+// Blank lines are skipped. Lines are read lazily, so arbitrarily large inputs
+// can be processed without loading them into memory.
+// If a line cannot be read or decoded, the iterator yields the zero value and
+// an error that includes the line number, then stops.
+func Decode[T any](r io.Reader) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		br := bufio.NewReader(r)
+		for line := 1; ; line++ {
+			b, err := br.ReadBytes('\n')
+			if err != nil && !errors.Is(err, io.EOF) {
+				var zero T
+				yield(zero, fmt.Errorf("jsonl: line %d: %w", line, err))
+				return
+			}
+			if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 {
+				var item T
+				if uerr := json.Unmarshal(trimmed, &item); uerr != nil {
+					yield(item, fmt.Errorf("jsonl: line %d: %w", line, uerr))
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Encode writes each item to w as a single line of JSON.
+func Encode[T any](w io.Writer, items []T) error {
+	return EncodeSeq(w, func(yield func(T) bool) {
+		for _, item := range items {
+			if !yield(item) {
+				return
+			}
+		}
+	})
+}
+
+// EncodeSeq writes each item produced by seq to w as a single line of JSON,
+// so results can be streamed out without collecting them into a slice first.
+func EncodeSeq[T any](w io.Writer, seq iter.Seq[T]) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+	var err error
+	seq(func(item T) bool {
+		err = enc.Encode(item)
+		return err == nil
+	})
+	if err != nil {
+		return fmt.Errorf("jsonl: %w", err)
+	}
+	return bw.Flush()
+}
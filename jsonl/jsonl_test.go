@@ -0,0 +1,79 @@
+package jsonl
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type event struct {
+	ID   int    `json:"id"`
+	Kind string `json:"kind"`
+}
+
+func TestDecode(t *testing.T) {
+	data := "{\"id\":1,\"kind\":\"a\"}\n\n{\"id\":2,\"kind\":\"b\"}\r\n{\"id\":3,\"kind\":\"c\"}"
+	var got []event
+	for item, err := range Decode[event](strings.NewReader(data)) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		got = append(got, item)
+	}
+	expected := []event{{1, "a"}, {2, "b"}, {3, "c"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestDecode_Error(t *testing.T) {
+	data := "{\"id\":1}\nnot json\n{\"id\":3}\n"
+	var (
+		count   int
+		lastErr error
+	)
+	for _, err := range Decode[event](strings.NewReader(data)) {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		count++
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 decoded item before the error, got %d", count)
+	}
+	if lastErr == nil || !strings.Contains(lastErr.Error(), "line 2") {
+		t.Errorf("Expected error on line 2, got %v", lastErr)
+	}
+}
+
+func TestDecode_Break(t *testing.T) {
+	data := "{\"id\":1}\n{\"id\":2}\n{\"id\":3}\n"
+	count := 0
+	for range Decode[event](strings.NewReader(data)) {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("Expected to stop after 2 items, got %d", count)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := Encode(buf, []event{{1, "a<b"}, {2, "c"}}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "{\"id\":1,\"kind\":\"a<b\"}\n{\"id\":2,\"kind\":\"c\"}\n"
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+
+	buf.Reset()
+	if err := Encode(buf, []func(){func() {}}); err == nil {
+		t.Error("Expected error for unsupported value")
+	}
+}
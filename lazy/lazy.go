@@ -0,0 +1,108 @@
+// Package lazy provides memoized values and lazy, possibly infinite, generators.
+package lazy
+
+import (
+	"iter"
+	"sync"
+)
+
+// Value is a memoized thunk: its function runs once, on first access.
+// A Value is safe for concurrent use.
+type Value[T any] struct {
+	once     sync.Once
+	fn       func() T
+	value    T
+	panicked bool
+	panicVal any
+}
+
+// New creates a Value that computes its result with fn on the first call to Get.
+func New[T any](fn func() T) *Value[T] {
+	return &Value[T]{fn: fn}
+}
+
+// Get returns the memoized value, computing it on the first call.
+// Concurrent callers block until the first computation finishes.
+// If fn panics, fn is not run again: Get panics with the same value on every call.
+func (v *Value[T]) Get() T {
+	v.once.Do(func() {
+		fn := v.fn
+		v.fn = nil
+		if fn == nil {
+			return
+		}
+		defer func() {
+			if r := recover(); r != nil {
+				v.panicked, v.panicVal = true, r
+				panic(r)
+			}
+		}()
+		v.value = fn()
+	})
+	if v.panicked {
+		panic(v.panicVal)
+	}
+	return v.value
+}
+
+// Slice returns an iterator that calls generator with indexes 0, 1, 2, ... and yields
+// each produced value, stopping once generator returns false.
+func Slice[T any](generator func(i int) (T, bool)) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if generator == nil {
+			return
+		}
+		for i := 0; ; i++ {
+			v, ok := generator(i)
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Iterate returns an infinite iterator yielding seed, next(seed), next(next(seed)), ...
+func Iterate[T any](seed T, next func(T) T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := seed; yield(v); v = next(v) {
+		}
+	}
+}
+
+// Repeat returns an infinite iterator that yields value forever.
+func Repeat[T any](value T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for yield(value) {
+		}
+	}
+}
+
+// Take collects at most n values from seq into a slice, stopping the iterator afterwards.
+// Returns nil if n <= 0.
+func Take[T any](seq iter.Seq[T], n int) []T {
+	if n <= 0 {
+		return nil
+	}
+	// n is only an upper bound, and may be huge for a short sequence.
+	result := make([]T, 0, min(n, 64))
+	for v := range seq {
+		result = append(result, v)
+		if len(result) == n {
+			break
+		}
+	}
+	return result
+}
+
+// TakeWhile collects values from seq into a slice for as long as predicate holds,
+// stopping the iterator at the first value that fails it.
+func TakeWhile[T any](seq iter.Seq[T], predicate func(T) bool) []T {
+	var result []T
+	for v := range seq {
+		if !predicate(v) {
+			break
+		}
+		result = append(result, v)
+	}
+	return result
+}
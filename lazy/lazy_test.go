@@ -0,0 +1,92 @@
+package lazy
+
+import (
+	"math"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestValue(t *testing.T) {
+	var calls int32
+	v := New(func() int {
+		atomic.AddInt32(&calls, 1)
+		return 42
+	})
+	if calls != 0 {
+		t.Error("Expected computation to be deferred until Get")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := v.Get(); got != 42 {
+				t.Errorf("Expected 42, got %d", got)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("Expected 1 computation, got %d", calls)
+	}
+}
+
+func TestValue_Panic(t *testing.T) {
+	var calls int32
+	v := New(func() int {
+		atomic.AddInt32(&calls, 1)
+		panic("boom")
+	})
+	for i := 0; i < 3; i++ {
+		func() {
+			defer func() {
+				if r := recover(); r != "boom" {
+					t.Errorf("Expected Get to panic with boom on call %d, got %v", i, r)
+				}
+			}()
+			v.Get()
+		}()
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 computation, got %d", calls)
+	}
+}
+
+func TestSlice(t *testing.T) {
+	squares := Slice(func(i int) (int, bool) {
+		return i * i, i < 5
+	})
+	expected := []int{0, 1, 4, 9, 16}
+	if got := Take(squares, 100); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	// A huge n must not be used to size the result up front.
+	if got := Take(squares, math.MaxInt); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestIterate(t *testing.T) {
+	powers := Iterate(1, func(n int) int { return n * 2 })
+	expected := []int{1, 2, 4, 8, 16}
+	if got := Take(powers, 5); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	expected = []int{1, 2, 4, 8}
+	if got := TakeWhile(powers, func(n int) bool { return n < 10 }); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	expected := []string{"x", "x", "x"}
+	if got := Take(Repeat("x"), 3); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if got := Take(Repeat("x"), 0); got != nil {
+		t.Errorf("Expected nil for n <= 0, got %v", got)
+	}
+}
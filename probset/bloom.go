@@ -0,0 +1,100 @@
+// Package probset provides probabilistic set data structures.
+package probset
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Bloom is a Bloom filter: a compact probabilistic set that may report false positives
+// but never false negatives.
+// A Bloom is not safe for concurrent use.
+type Bloom[T any] struct {
+	bits   []uint64
+	size   uint64 // number of bits
+	hashes int    // number of hash functions
+	hash   func(T) uint64
+	count  int
+}
+
+// NewBloom creates a Bloom filter sized to hold expectedItems elements with roughly the
+// given false-positive rate, using hash to fingerprint elements.
+// hash should spread values well over all 64 bits (e.g. FNV-1a or xxhash).
+// expectedItems < 1 is treated as 1, and falsePositiveRate is clamped to [1e-12, 0.5].
+func NewBloom[T any](expectedItems int, falsePositiveRate float64, hash func(T) uint64) *Bloom[T] {
+	n := float64(max(expectedItems, 1))
+	p := math.Min(math.Max(falsePositiveRate, 1e-12), 0.5)
+
+	m := math.Ceil(-n * math.Log(p) / (math.Ln2 * math.Ln2))
+	k := max(int(math.Round(m/n*math.Ln2)), 1)
+	size := uint64(m)
+
+	return &Bloom[T]{
+		bits:   make([]uint64, (size+63)/64),
+		size:   size,
+		hashes: k,
+		hash:   hash,
+	}
+}
+
+// Add inserts value into the filter.
+func (b *Bloom[T]) Add(value T) {
+	h1, h2 := b.split(value)
+	for i := 0; i < b.hashes; i++ {
+		idx := (h1 + uint64(i)*h2) % b.size
+		b.bits[idx/64] |= 1 << (idx % 64)
+	}
+	b.count++
+}
+
+// MightContain reports whether value may have been added to the filter.
+// A false result means value was definitely never added.
+func (b *Bloom[T]) MightContain(value T) bool {
+	h1, h2 := b.split(value)
+	for i := 0; i < b.hashes; i++ {
+		idx := (h1 + uint64(i)*h2) % b.size
+		if b.bits[idx/64]&(1<<(idx%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Count returns the number of Add calls, including duplicates.
+func (b *Bloom[T]) Count() int {
+	return b.count
+}
+
+// Bits returns the number of bits in the filter.
+func (b *Bloom[T]) Bits() uint64 {
+	return b.size
+}
+
+// Hashes returns the number of hash functions applied per element.
+func (b *Bloom[T]) Hashes() int {
+	return b.hashes
+}
+
+// EstimatedFalsePositiveRate returns the expected false-positive rate given the number
+// of elements added so far.
+func (b *Bloom[T]) EstimatedFalsePositiveRate() float64 {
+	k := float64(b.hashes)
+	return math.Pow(1-math.Exp(-k*float64(b.count)/float64(b.size)), k)
+}
+
+// Reset removes all elements from the filter.
+func (b *Bloom[T]) Reset() {
+	clear(b.bits)
+	b.count = 0
+}
+
+// split derives two independent hashes from the user hash for double hashing.
+func (b *Bloom[T]) split(value T) (uint64, uint64) {
+	h := b.hash(value)
+	// splitmix64 finalizer, so a weak user hash still yields a well-mixed second hash.
+	z := h + 0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	z ^= z >> 31
+	return h, bits.RotateLeft64(z, 1) | 1
+}
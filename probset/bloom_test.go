@@ -0,0 +1,65 @@
+package probset
+
+import (
+	"hash/fnv"
+	"strconv"
+	"testing"
+)
+
+func hashString(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	return h.Sum64()
+}
+
+func TestBloom_NoFalseNegatives(t *testing.T) {
+	b := NewBloom(1000, 0.01, hashString)
+	for i := 0; i < 1000; i++ {
+		b.Add("item-" + strconv.Itoa(i))
+	}
+	for i := 0; i < 1000; i++ {
+		if !b.MightContain("item-" + strconv.Itoa(i)) {
+			t.Fatalf("Expected item-%d to be reported as present", i)
+		}
+	}
+	if b.Count() != 1000 {
+		t.Errorf("Expected count 1000, got %d", b.Count())
+	}
+}
+
+func TestBloom_FalsePositiveRate(t *testing.T) {
+	const n = 10000
+	b := NewBloom(n, 0.01, hashString)
+	for i := 0; i < n; i++ {
+		b.Add("in-" + strconv.Itoa(i))
+	}
+	falsePositives := 0
+	for i := 0; i < n; i++ {
+		if b.MightContain("out-" + strconv.Itoa(i)) {
+			falsePositives++
+		}
+	}
+	if rate := float64(falsePositives) / n; rate > 0.03 {
+		t.Errorf("Expected false-positive rate near 1%%, got %.2f%%", rate*100)
+	}
+	if est := b.EstimatedFalsePositiveRate(); est < 0.005 || est > 0.02 {
+		t.Errorf("Expected estimated rate near 1%%, got %.4f", est)
+	}
+}
+
+func TestBloom_Sizing(t *testing.T) {
+	b := NewBloom(1000, 0.01, func(v int) uint64 { return uint64(v) })
+	// m = -n ln p / ln2^2 ≈ 9586 bits, k ≈ 7
+	if b.Bits() < 9500 || b.Bits() > 9700 {
+		t.Errorf("Expected about 9586 bits, got %d", b.Bits())
+	}
+	if b.Hashes() != 7 {
+		t.Errorf("Expected 7 hash functions, got %d", b.Hashes())
+	}
+
+	b.Add(42)
+	b.Reset()
+	if b.MightContain(42) || b.Count() != 0 {
+		t.Error("Expected Reset to clear the filter")
+	}
+}
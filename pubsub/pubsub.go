@@ -0,0 +1,219 @@
+// Package pubsub provides in-process publish/subscribe fan-out.
+package pubsub
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/cirius-go/devutil/record"
+)
+
+// Mode controls what Publish does when a subscriber's buffer is full.
+type Mode int
+
+const (
+	// Blocking makes Publish wait until every subscriber has room for the message.
+	Blocking Mode = iota
+	// DropIfFull makes Publish skip subscribers whose buffer is full.
+	DropIfFull
+)
+
+// Topic delivers every published message to all of its current subscribers.
+// A Topic is safe for concurrent use.
+type Topic[T any] struct {
+	mu        sync.RWMutex
+	mode      Mode
+	subs      map[*Subscription[T]]struct{}
+	closed    bool
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewTopic creates a Topic that delivers messages according to mode.
+func NewTopic[T any](mode Mode) *Topic[T] {
+	return &Topic[T]{
+		mode: mode,
+		subs: make(map[*Subscription[T]]struct{}),
+		done: make(chan struct{}),
+	}
+}
+
+// Subscribe registers a new subscriber whose channel buffers up to buffer messages.
+// Subscribing to a closed topic returns an already closed subscription.
+func (t *Topic[T]) Subscribe(buffer int) *Subscription[T] {
+	s := &Subscription[T]{
+		topic: t,
+		ch:    make(chan T, max(buffer, 0)),
+		done:  make(chan struct{}),
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		s.stop()
+		close(s.ch)
+		return s
+	}
+	t.subs[s] = struct{}{}
+	return s
+}
+
+// Publish sends msg to every subscriber and returns how many received it.
+// In Blocking mode it waits for room in each subscriber's buffer, unless the
+// subscriber unsubscribes or the topic closes meanwhile; in DropIfFull mode full
+// subscribers are skipped.
+func (t *Topic[T]) Publish(msg T) int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.closed {
+		return 0
+	}
+	delivered := 0
+	for s := range t.subs {
+		if t.mode == DropIfFull {
+			select {
+			case s.ch <- msg:
+				delivered++
+			default:
+			}
+			continue
+		}
+		select {
+		case s.ch <- msg:
+			delivered++
+		case <-s.done:
+		case <-t.done:
+		}
+	}
+	return delivered
+}
+
+// Subscribers returns the number of active subscribers.
+func (t *Topic[T]) Subscribers() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.subs)
+}
+
+// Close closes the topic and all of its subscriptions. Subscribers can still drain
+// messages already buffered in their channels.
+func (t *Topic[T]) Close() {
+	// Unblock pending publishers before taking the write lock.
+	t.closeOnce.Do(func() { close(t.done) })
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return
+	}
+	t.closed = true
+	for s := range t.subs {
+		s.stop()
+		close(s.ch)
+	}
+	clear(t.subs)
+}
+
+// remove unregisters s and closes its channel.
+func (t *Topic[T]) remove(s *Subscription[T]) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.subs[s]; !ok {
+		return
+	}
+	delete(t.subs, s)
+	close(s.ch)
+}
+
+// Subscription receives the messages published to a Topic.
+type Subscription[T any] struct {
+	topic    *Topic[T]
+	ch       chan T
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// C returns the channel messages are delivered on. It is closed when the
+// subscription ends.
+func (s *Subscription[T]) C() <-chan T {
+	return s.ch
+}
+
+// All returns an iterator over incoming messages that ends when the subscription
+// is closed. Breaking out of the loop does not unsubscribe.
+func (s *Subscription[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for msg := range s.ch {
+			if !yield(msg) {
+				return
+			}
+		}
+	}
+}
+
+// Unsubscribe stops delivery to this subscription and closes its channel.
+// It is safe to call more than once.
+func (s *Subscription[T]) Unsubscribe() {
+	s.stop()
+	s.topic.remove(s)
+}
+
+// stop signals pending publishers to give up on this subscription.
+func (s *Subscription[T]) stop() {
+	s.stopOnce.Do(func() { close(s.done) })
+}
+
+// Bus manages a set of topics keyed by K, creating them on first use.
+// A Bus is safe for concurrent use.
+type Bus[K comparable, T any] struct {
+	mu     sync.Mutex
+	topics *record.DefaultMap[K, *Topic[T]]
+}
+
+// NewBus creates a Bus whose topics deliver messages according to mode.
+func NewBus[K comparable, T any](mode Mode) *Bus[K, T] {
+	return &Bus[K, T]{
+		topics: record.NewDefaultMap(func(K) *Topic[T] { return NewTopic[T](mode) }),
+	}
+}
+
+// Topic returns the topic for key, creating it if needed.
+func (b *Bus[K, T]) Topic(key K) *Topic[T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.topics.Get(key)
+}
+
+// Subscribe subscribes to the topic for key, creating it if needed.
+func (b *Bus[K, T]) Subscribe(key K, buffer int) *Subscription[T] {
+	return b.Topic(key).Subscribe(buffer)
+}
+
+// Publish sends msg to the topic for key and returns how many subscribers received it.
+// Publishing to a key nobody has subscribed to is a no-op.
+func (b *Bus[K, T]) Publish(key K, msg T) int {
+	b.mu.Lock()
+	t, ok := b.topics.Lookup(key)
+	b.mu.Unlock()
+	if !ok {
+		return 0
+	}
+	return t.Publish(msg)
+}
+
+// Keys returns the keys of all topics. The order of keys is not guaranteed.
+func (b *Bus[K, T]) Keys() []K {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return record.Keys(b.topics.Map())
+}
+
+// Close closes every topic and removes them from the bus.
+func (b *Bus[K, T]) Close() {
+	b.mu.Lock()
+	topics := record.Values(b.topics.Map())
+	clear(b.topics.Map())
+	b.mu.Unlock()
+	for _, t := range topics {
+		t.Close()
+	}
+}
@@ -0,0 +1,120 @@
+package pubsub
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTopic_FanOut(t *testing.T) {
+	topic := NewTopic[int](Blocking)
+	s1 := topic.Subscribe(4)
+	s2 := topic.Subscribe(4)
+
+	for i := 1; i <= 3; i++ {
+		if n := topic.Publish(i); n != 2 {
+			t.Errorf("Expected delivery to 2 subscribers, got %d", n)
+		}
+	}
+	topic.Close()
+
+	for _, s := range []*Subscription[int]{s1, s2} {
+		var got []int
+		for msg := range s.All() {
+			got = append(got, msg)
+		}
+		if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", got)
+		}
+	}
+	if n := topic.Publish(4); n != 0 {
+		t.Errorf("Expected no delivery after Close, got %d", n)
+	}
+}
+
+func TestTopic_DropIfFull(t *testing.T) {
+	topic := NewTopic[string](DropIfFull)
+	s := topic.Subscribe(1)
+
+	if n := topic.Publish("a"); n != 1 {
+		t.Errorf("Expected delivery, got %d", n)
+	}
+	if n := topic.Publish("b"); n != 0 {
+		t.Errorf("Expected drop on full buffer, got %d", n)
+	}
+	if msg := <-s.C(); msg != "a" {
+		t.Errorf("Expected a, got %s", msg)
+	}
+}
+
+func TestTopic_UnsubscribeUnblocksPublisher(t *testing.T) {
+	topic := NewTopic[int](Blocking)
+	s := topic.Subscribe(0)
+
+	done := make(chan int)
+	go func() { done <- topic.Publish(1) }()
+
+	time.Sleep(10 * time.Millisecond)
+	s.Unsubscribe()
+	s.Unsubscribe()
+
+	select {
+	case n := <-done:
+		if n != 0 {
+			t.Errorf("Expected no delivery, got %d", n)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Publish did not return after Unsubscribe")
+	}
+	if topic.Subscribers() != 0 {
+		t.Errorf("Expected 0 subscribers, got %d", topic.Subscribers())
+	}
+	if _, ok := <-s.C(); ok {
+		t.Error("Expected subscription channel to be closed")
+	}
+}
+
+func TestBus(t *testing.T) {
+	bus := NewBus[string, int](Blocking)
+	orders := bus.Subscribe("orders", 10)
+	users := bus.Subscribe("users", 10)
+
+	if n := bus.Publish("missing", 1); n != 0 {
+		t.Errorf("Expected no delivery to unknown key, got %d", n)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			bus.Publish("orders", i)
+		}(i)
+	}
+	wg.Wait()
+	bus.Publish("users", 100)
+
+	keys := bus.Keys()
+	sort.Strings(keys)
+	if !reflect.DeepEqual(keys, []string{"orders", "users"}) {
+		t.Errorf("Expected keys [orders users], got %v", keys)
+	}
+
+	bus.Close()
+	var got []int
+	for msg := range orders.All() {
+		got = append(got, msg)
+	}
+	sort.Ints(got)
+	if !reflect.DeepEqual(got, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("Expected [0 1 2 3 4], got %v", got)
+	}
+	if msg := <-users.C(); msg != 100 {
+		t.Errorf("Expected 100, got %d", msg)
+	}
+	if len(bus.Keys()) != 0 {
+		t.Error("Expected no topics after Close")
+	}
+}
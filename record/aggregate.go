@@ -0,0 +1,62 @@
+package record
+
+import (
+	"cmp"
+
+	"github.com/cirius-go/devutil/internal/numeric"
+)
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number = numeric.Number
+
+// SumValues returns the sum of the map's values. Returns 0 for an empty map.
+func SumValues[K comparable, V Number](m map[K]V) V {
+	var sum V
+	for _, v := range m {
+		sum += v
+	}
+	return sum
+}
+
+// MinValue returns the smallest value in the map and true, or the zero value and false
+// if the map is empty.
+func MinValue[K comparable, V cmp.Ordered](m map[K]V) (V, bool) {
+	_, v, ok := MinByValue(m)
+	return v, ok
+}
+
+// MaxValue returns the largest value in the map and true, or the zero value and false
+// if the map is empty.
+func MaxValue[K comparable, V cmp.Ordered](m map[K]V) (V, bool) {
+	_, v, ok := MaxByValue(m)
+	return v, ok
+}
+
+// MinByValue returns the entry with the smallest value and true, or zero values and false
+// if the map is empty. If several keys share the smallest value, which one is returned
+// is unspecified.
+func MinByValue[K comparable, V cmp.Ordered](m map[K]V) (K, V, bool) {
+	return extremeEntry(m, func(a, b V) bool { return cmp.Less(a, b) })
+}
+
+// MaxByValue returns the entry with the largest value and true, or zero values and false
+// if the map is empty. If several keys share the largest value, which one is returned
+// is unspecified.
+func MaxByValue[K comparable, V cmp.Ordered](m map[K]V) (K, V, bool) {
+	return extremeEntry(m, func(a, b V) bool { return cmp.Less(b, a) })
+}
+
+// extremeEntry returns the entry whose value is preferred by better over all others.
+func extremeEntry[K comparable, V any](m map[K]V, better func(a, b V) bool) (K, V, bool) {
+	var (
+		bestK K
+		bestV V
+		found bool
+	)
+	for k, v := range m {
+		if !found || better(v, bestV) {
+			bestK, bestV, found = k, v, true
+		}
+	}
+	return bestK, bestV, found
+}
@@ -0,0 +1,41 @@
+package record
+
+import "testing"
+
+func TestSumValues(t *testing.T) {
+	if got := SumValues(map[string]int{"a": 1, "b": 2, "c": 3}); got != 6 {
+		t.Errorf("Expected 6, got %d", got)
+	}
+	if got := SumValues(map[string]float64{"a": 0.5, "b": 0.25}); got != 0.75 {
+		t.Errorf("Expected 0.75, got %v", got)
+	}
+	if got := SumValues[string, int](nil); got != 0 {
+		t.Errorf("Expected 0 for nil map, got %d", got)
+	}
+}
+
+func TestMinMaxValue(t *testing.T) {
+	m := map[string]int{"a": 3, "b": -1, "c": 7}
+	if v, ok := MinValue(m); !ok || v != -1 {
+		t.Errorf("Expected min -1, got %d (ok=%v)", v, ok)
+	}
+	if v, ok := MaxValue(m); !ok || v != 7 {
+		t.Errorf("Expected max 7, got %d (ok=%v)", v, ok)
+	}
+	if _, ok := MinValue(map[string]int{}); ok {
+		t.Error("Expected no min for empty map")
+	}
+}
+
+func TestMinMaxByValue(t *testing.T) {
+	scores := map[string]int{"alice": 90, "bob": 75, "carol": 98}
+	if k, v, ok := MaxByValue(scores); !ok || k != "carol" || v != 98 {
+		t.Errorf("Expected carol=98, got %s=%d (ok=%v)", k, v, ok)
+	}
+	if k, v, ok := MinByValue(scores); !ok || k != "bob" || v != 75 {
+		t.Errorf("Expected bob=75, got %s=%d (ok=%v)", k, v, ok)
+	}
+	if k, v, ok := MaxByValue[string, int](nil); ok || k != "" || v != 0 {
+		t.Errorf("Expected zero values for nil map, got %q=%d (ok=%v)", k, v, ok)
+	}
+}
@@ -0,0 +1,166 @@
+package record
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+)
+
+// Counter counts occurrences of keys.
+// A Counter is not safe for concurrent use; see SyncCounter.
+type Counter[K comparable] struct {
+	m map[K]int
+}
+
+// NewCounter creates a Counter, counting each of the given keys once.
+func NewCounter[K comparable](keys ...K) *Counter[K] {
+	c := &Counter[K]{m: make(map[K]int, len(keys))}
+	for _, k := range keys {
+		c.m[k]++
+	}
+	return c
+}
+
+// Inc increments the count for key by one and returns the new count.
+func (c *Counter[K]) Inc(key K) int {
+	return c.Add(key, 1)
+}
+
+// Add adds n to the count for key and returns the new count.
+func (c *Counter[K]) Add(key K, n int) int {
+	c.m[key] += n
+	return c.m[key]
+}
+
+// Get returns the count for key, or 0 if it was never counted.
+func (c *Counter[K]) Get(key K) int {
+	return c.m[key]
+}
+
+// Len returns the number of distinct keys.
+func (c *Counter[K]) Len() int {
+	return len(c.m)
+}
+
+// Total returns the sum of all counts.
+func (c *Counter[K]) Total() int {
+	total := 0
+	for _, n := range c.m {
+		total += n
+	}
+	return total
+}
+
+// Top returns the n keys with the highest counts, in descending order of count.
+// If n exceeds the number of keys, all keys are returned. Returns nil if n <= 0.
+// The order of keys with equal counts is not specified.
+func (c *Counter[K]) Top(n int) []Entry[K, int] {
+	if n <= 0 {
+		return nil
+	}
+	entries := c.All()
+	if n < len(entries) {
+		entries = entries[:n]
+	}
+	return entries
+}
+
+// All returns every key with its count, in descending order of count.
+// The order of keys with equal counts is not specified.
+func (c *Counter[K]) All() []Entry[K, int] {
+	entries := make([]Entry[K, int], 0, len(c.m))
+	for k, v := range c.m {
+		entries = append(entries, Entry[K, int]{Key: k, Value: v})
+	}
+	slices.SortFunc(entries, func(a, b Entry[K, int]) int {
+		return cmp.Compare(b.Value, a.Value)
+	})
+	return entries
+}
+
+// Merge adds all counts from other into c.
+func (c *Counter[K]) Merge(other *Counter[K]) {
+	for k, n := range other.m {
+		c.m[k] += n
+	}
+}
+
+// Map returns the underlying map, so it can be used with other record helpers.
+// Changes to the returned map are visible through the Counter.
+func (c *Counter[K]) Map() map[K]int {
+	return c.m
+}
+
+// SyncCounter is a Counter that is safe for concurrent use.
+type SyncCounter[K comparable] struct {
+	mu sync.Mutex
+	c  *Counter[K]
+}
+
+// NewSyncCounter creates a SyncCounter, counting each of the given keys once.
+func NewSyncCounter[K comparable](keys ...K) *SyncCounter[K] {
+	return &SyncCounter[K]{c: NewCounter(keys...)}
+}
+
+// Inc increments the count for key by one and returns the new count.
+func (s *SyncCounter[K]) Inc(key K) int {
+	return s.Add(key, 1)
+}
+
+// Add adds n to the count for key and returns the new count.
+func (s *SyncCounter[K]) Add(key K, n int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Add(key, n)
+}
+
+// Get returns the count for key, or 0 if it was never counted.
+func (s *SyncCounter[K]) Get(key K) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Get(key)
+}
+
+// Len returns the number of distinct keys.
+func (s *SyncCounter[K]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Len()
+}
+
+// Total returns the sum of all counts.
+func (s *SyncCounter[K]) Total() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Total()
+}
+
+// Top returns the n keys with the highest counts, in descending order of count.
+// If n exceeds the number of keys, all keys are returned. Returns nil if n <= 0.
+func (s *SyncCounter[K]) Top(n int) []Entry[K, int] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Top(n)
+}
+
+// All returns every key with its count, in descending order of count.
+func (s *SyncCounter[K]) All() []Entry[K, int] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.All()
+}
+
+// Merge adds all counts from other into s. It is typically used to fold
+// per-goroutine Counters into a shared total.
+func (s *SyncCounter[K]) Merge(other *Counter[K]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.c.Merge(other)
+}
+
+// Snapshot returns a copy of the current counts.
+func (s *SyncCounter[K]) Snapshot() map[K]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return Clone(s.c.m)
+}
@@ -0,0 +1,93 @@
+package record
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestCounter(t *testing.T) {
+	c := NewCounter("a", "b", "a")
+	if n := c.Inc("a"); n != 3 {
+		t.Errorf("Expected 3, got %d", n)
+	}
+	if n := c.Add("c", 5); n != 5 {
+		t.Errorf("Expected 5, got %d", n)
+	}
+	if c.Get("b") != 1 || c.Get("missing") != 0 {
+		t.Errorf("Unexpected counts %v", c.Map())
+	}
+	if c.Len() != 3 || c.Total() != 9 {
+		t.Errorf("Expected Len 3 and Total 9, got %d and %d", c.Len(), c.Total())
+	}
+}
+
+func TestCounter_Top(t *testing.T) {
+	c := NewCounter("x", "y", "y", "z", "z", "z")
+
+	expected := []Entry[string, int]{{"z", 3}, {"y", 2}}
+	if top := c.Top(2); !reflect.DeepEqual(top, expected) {
+		t.Errorf("Expected %v, got %v", expected, top)
+	}
+	if top := c.Top(5); len(top) != 3 || top[2] != (Entry[string, int]{"x", 1}) {
+		t.Errorf("Expected all keys, got %v", top)
+	}
+	for _, n := range []int{0, -1} {
+		if top := c.Top(n); top != nil {
+			t.Errorf("Expected nil for n = %d, got %v", n, top)
+		}
+	}
+	if top := NewCounter[string]().Top(3); len(top) != 0 {
+		t.Errorf("Expected empty result, got %v", top)
+	}
+}
+
+func TestCounter_All(t *testing.T) {
+	c := NewCounter("x", "y", "y", "z", "z", "z")
+	expected := []Entry[string, int]{{"z", 3}, {"y", 2}, {"x", 1}}
+	if all := c.All(); !reflect.DeepEqual(all, expected) {
+		t.Errorf("Expected %v, got %v", expected, all)
+	}
+	if all := NewSyncCounter("a", "a", "b").All(); !reflect.DeepEqual(all, []Entry[string, int]{{"a", 2}, {"b", 1}}) {
+		t.Errorf("Expected [{a 2} {b 1}], got %v", all)
+	}
+}
+
+func TestCounter_Merge(t *testing.T) {
+	c := NewCounter("a", "b")
+	c.Merge(NewCounter("b", "c"))
+	expected := map[string]int{"a": 1, "b": 2, "c": 1}
+	if !reflect.DeepEqual(c.Map(), expected) {
+		t.Errorf("Expected %v, got %v", expected, c.Map())
+	}
+}
+
+func TestSyncCounter(t *testing.T) {
+	s := NewSyncCounter[int]()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			local := NewCounter[int]()
+			for i := 0; i < 100; i++ {
+				s.Inc(i % 4)
+				local.Inc(i % 2)
+			}
+			s.Merge(local)
+		}()
+	}
+	wg.Wait()
+
+	expected := map[int]int{0: 600, 1: 600, 2: 200, 3: 200}
+	if snap := s.Snapshot(); !reflect.DeepEqual(snap, expected) {
+		t.Errorf("Expected %v, got %v", expected, snap)
+	}
+	if s.Total() != 1600 || s.Len() != 4 || s.Get(0) != 600 {
+		t.Errorf("Unexpected totals: total=%d len=%d", s.Total(), s.Len())
+	}
+	if top := s.Top(1); len(top) != 1 || top[0].Value != 600 {
+		t.Errorf("Expected top count 600, got %v", top)
+	}
+}
@@ -0,0 +1,65 @@
+package record
+
+// DefaultMap is a map that creates missing values on access using a factory,
+// similar to Python's defaultdict.
+// A DefaultMap is not safe for concurrent use.
+type DefaultMap[K comparable, V any] struct {
+	m       map[K]V
+	factory func(key K) V
+}
+
+// NewDefaultMap creates an empty DefaultMap that uses factory to build missing values.
+// If factory is nil, missing values default to the zero value of V.
+func NewDefaultMap[K comparable, V any](factory func(key K) V) *DefaultMap[K, V] {
+	return &DefaultMap[K, V]{
+		m:       make(map[K]V),
+		factory: factory,
+	}
+}
+
+// Get returns the value stored for key.
+// If the key is missing, a value is created with the factory, stored and returned.
+func (d *DefaultMap[K, V]) Get(key K) V {
+	if v, ok := d.m[key]; ok {
+		return v
+	}
+	var v V
+	if d.factory != nil {
+		v = d.factory(key)
+	}
+	d.m[key] = v
+	return v
+}
+
+// Lookup returns the value stored for key without creating it.
+func (d *DefaultMap[K, V]) Lookup(key K) (V, bool) {
+	v, ok := d.m[key]
+	return v, ok
+}
+
+// Set stores value for key.
+func (d *DefaultMap[K, V]) Set(key K, value V) {
+	d.m[key] = value
+}
+
+// Update replaces the value for key with the result of fn, creating the current
+// value with the factory if the key is missing.
+func (d *DefaultMap[K, V]) Update(key K, fn func(V) V) {
+	d.m[key] = fn(d.Get(key))
+}
+
+// Delete removes key from the map.
+func (d *DefaultMap[K, V]) Delete(key K) {
+	delete(d.m, key)
+}
+
+// Len returns the number of entries in the map.
+func (d *DefaultMap[K, V]) Len() int {
+	return len(d.m)
+}
+
+// Map returns the underlying map, so it can be used with other record helpers.
+// Changes to the returned map are visible through the DefaultMap.
+func (d *DefaultMap[K, V]) Map() map[K]V {
+	return d.m
+}
@@ -0,0 +1,48 @@
+package record
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDefaultMap(t *testing.T) {
+	groups := NewDefaultMap(func(string) []string { return nil })
+	for _, w := range []string{"apple", "avocado", "banana"} {
+		groups.Update(w[:1], func(v []string) []string { return append(v, w) })
+	}
+	expected := map[string][]string{
+		"a": {"apple", "avocado"},
+		"b": {"banana"},
+	}
+	if !reflect.DeepEqual(groups.Map(), expected) {
+		t.Errorf("Expected %v, got %v", expected, groups.Map())
+	}
+}
+
+func TestDefaultMap_Get(t *testing.T) {
+	calls := 0
+	d := NewDefaultMap(func(k int) int {
+		calls++
+		return k * 10
+	})
+
+	if v := d.Get(2); v != 20 {
+		t.Errorf("Expected 20, got %d", v)
+	}
+	if v := d.Get(2); v != 20 || calls != 1 {
+		t.Errorf("Expected cached value 20 with 1 factory call, got %d with %d calls", v, calls)
+	}
+	if _, ok := d.Lookup(3); ok {
+		t.Error("Expected Lookup not to create missing keys")
+	}
+	d.Set(3, 1)
+	d.Delete(2)
+	if d.Len() != 1 {
+		t.Errorf("Expected length 1, got %d", d.Len())
+	}
+
+	zero := NewDefaultMap[string, int](nil)
+	if v := zero.Get("x"); v != 0 || zero.Len() != 1 {
+		t.Errorf("Expected zero value to be stored, got %d (len %d)", v, zero.Len())
+	}
+}
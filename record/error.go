@@ -0,0 +1,102 @@
+package record
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cirius-go/devutil/internal/panicerr"
+)
+
+// PanicError represents a panic recovered from a mapper or predicate run by
+// MapValuesParallel or FilterParallel. Its Index is -1; the key is on the KeyError.
+// It is the same type as the PanicError of the slice, async, sched and flight packages.
+type PanicError = panicerr.Error
+
+// KeyError represents an error related to a map entry.
+type KeyError[K comparable] struct {
+	Key K
+	Err error
+}
+
+// Error implements the error interface for KeyError.
+func (e *KeyError[K]) Error() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return ""
+}
+
+// Unwrap returns the underlying error.
+func (e *KeyError[K]) Unwrap() error {
+	return e.Err
+}
+
+// RecordError represents an error related to record operations.
+type RecordError[K comparable] []*KeyError[K]
+
+// Error implements the error interface for RecordError.
+func (e RecordError[K]) Error() string {
+	if len(e) == 0 {
+		return ""
+	}
+	b := &strings.Builder{}
+	for _, err := range e {
+		b.WriteString(err.Error())
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// Unwrap returns the underlying errors, so errors.Is and errors.As match any of them.
+func (e RecordError[K]) Unwrap() []error {
+	if len(e) == 0 {
+		return nil
+	}
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err.Err)
+	}
+	return errs
+}
+
+// At returns the error for the specified key, or nil if there is none.
+func (e RecordError[K]) At(key K) error {
+	for _, err := range e {
+		if err.Key == key {
+			return err
+		}
+	}
+	return nil
+}
+
+// OriginAt returns the original error for the specified key, or nil if there is none.
+func (e RecordError[K]) OriginAt(key K) error {
+	for _, err := range e {
+		if err.Key == key {
+			return err.Err
+		}
+	}
+	return nil
+}
+
+// Keys returns the keys that have an error, in the order the errors were recorded.
+func (e RecordError[K]) Keys() []K {
+	if len(e) == 0 {
+		return nil
+	}
+	keys := make([]K, len(e))
+	for i, err := range e {
+		keys[i] = err.Key
+	}
+	return keys
+}
+
+// KeyNotFoundError reports a key that is missing from a map.
+type KeyNotFoundError[K comparable] struct {
+	Key K
+}
+
+// Error implements the error interface for KeyNotFoundError.
+func (e *KeyNotFoundError[K]) Error() string {
+	return fmt.Sprintf("record: key %v not found", e.Key)
+}
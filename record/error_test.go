@@ -0,0 +1,40 @@
+package record
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestRecordError(t *testing.T) {
+	errA := errors.New("a failed")
+	errB := errors.New("b failed")
+	err := RecordError[string]{
+		{Key: "a", Err: errA},
+		{Key: "b", Err: errB},
+	}
+
+	if !reflect.DeepEqual(err.Keys(), []string{"a", "b"}) {
+		t.Errorf("Expected keys [a b], got %v", err.Keys())
+	}
+	if got := err.At("b"); got == nil || !errors.Is(got, errB) {
+		t.Errorf("Expected KeyError wrapping errB, got %v", got)
+	}
+	if err.At("missing") != nil || err.OriginAt("missing") != nil {
+		t.Error("Expected nil for a key without an error")
+	}
+	if err.OriginAt("a") != errA {
+		t.Errorf("Expected errA, got %v", err.OriginAt("a"))
+	}
+	if !reflect.DeepEqual(err.Unwrap(), []error{errA, errB}) {
+		t.Errorf("Expected [errA errB], got %v", err.Unwrap())
+	}
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Error("Expected errors.Is to match every underlying error")
+	}
+
+	var empty RecordError[string]
+	if empty.Keys() != nil || empty.Unwrap() != nil || empty.Error() != "" {
+		t.Error("Expected empty RecordError to report nothing")
+	}
+}
@@ -0,0 +1,35 @@
+package record
+
+// GetErr returns the value stored under key, or a *KeyNotFoundError if there is none.
+func GetErr[K comparable, V any](m map[K]V, key K) (V, error) {
+	v, ok := m[key]
+	if !ok {
+		return v, &KeyNotFoundError[K]{Key: key}
+	}
+	return v, nil
+}
+
+// MustGet returns the value stored under key. It panics with a *KeyNotFoundError
+// naming the key if there is none.
+func MustGet[K comparable, V any](m map[K]V, key K) V {
+	v, err := GetErr(m, key)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ensure checks that every key is present in the map. It returns a RecordError with a
+// *KeyNotFoundError for each missing key, in the order given, or nil if none is missing.
+func Ensure[K comparable, V any](m map[K]V, keys ...K) error {
+	var errs RecordError[K]
+	for _, k := range keys {
+		if _, ok := m[k]; !ok {
+			errs = append(errs, &KeyError[K]{Key: k, Err: &KeyNotFoundError[K]{Key: k}})
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
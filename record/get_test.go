@@ -0,0 +1,58 @@
+package record
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestGetErr(t *testing.T) {
+	m := map[string]int{"port": 8080}
+	if v, err := GetErr(m, "port"); v != 8080 || err != nil {
+		t.Errorf("Expected 8080, nil, got %v, %v", v, err)
+	}
+
+	_, err := GetErr(m, "host")
+	var notFound *KeyNotFoundError[string]
+	if !errors.As(err, &notFound) || notFound.Key != "host" {
+		t.Fatalf("Expected KeyNotFoundError for host, got %v", err)
+	}
+	if err.Error() != "record: key host not found" {
+		t.Errorf("Unexpected message %q", err.Error())
+	}
+}
+
+func TestMustGet(t *testing.T) {
+	m := map[int]string{1: "one"}
+	if got := MustGet(m, 1); got != "one" {
+		t.Errorf("Expected one, got %v", got)
+	}
+
+	defer func() {
+		err, ok := recover().(*KeyNotFoundError[int])
+		if !ok || err.Key != 2 {
+			t.Errorf("Expected panic with KeyNotFoundError for 2, got %v", err)
+		}
+	}()
+	MustGet(m, 2)
+}
+
+func TestEnsure(t *testing.T) {
+	cfg := map[string]string{"host": "localhost"}
+	if err := Ensure(cfg, "host"); err != nil {
+		t.Errorf("Expected nil, got %v", err)
+	}
+
+	err := Ensure(cfg, "port", "host", "user")
+	var recErr RecordError[string]
+	if !errors.As(err, &recErr) {
+		t.Fatalf("Expected RecordError, got %v", err)
+	}
+	if !reflect.DeepEqual(recErr.Keys(), []string{"port", "user"}) {
+		t.Errorf("Expected missing keys [port user], got %v", recErr.Keys())
+	}
+	var notFound *KeyNotFoundError[string]
+	if !errors.As(err, &notFound) {
+		t.Errorf("Expected errors.As to find a KeyNotFoundError, got %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package record
+
+// Pair holds the values found for a key in two maps.
+// HasLeft and HasRight report whether the key was present in each map;
+// a missing side holds the zero value.
+type Pair[A, B any] struct {
+	Left     A
+	Right    B
+	HasLeft  bool
+	HasRight bool
+}
+
+// ZipByKey pairs the values of a and b that share a key (an inner join).
+// Keys present in only one map are dropped.
+func ZipByKey[K comparable, A, B any](a map[K]A, b map[K]B) map[K]Pair[A, B] {
+	result := make(map[K]Pair[A, B], min(len(a), len(b)))
+	for k, av := range a {
+		if bv, ok := b[k]; ok {
+			result[k] = Pair[A, B]{Left: av, Right: bv, HasLeft: true, HasRight: true}
+		}
+	}
+	return result
+}
+
+// LeftJoinByKey pairs every value of a with the value of b under the same key, if any.
+// Keys present only in b are dropped.
+func LeftJoinByKey[K comparable, A, B any](a map[K]A, b map[K]B) map[K]Pair[A, B] {
+	result := make(map[K]Pair[A, B], len(a))
+	for k, av := range a {
+		bv, ok := b[k]
+		result[k] = Pair[A, B]{Left: av, Right: bv, HasLeft: true, HasRight: ok}
+	}
+	return result
+}
+
+// OuterJoinByKey pairs the values of a and b for every key present in either map.
+func OuterJoinByKey[K comparable, A, B any](a map[K]A, b map[K]B) map[K]Pair[A, B] {
+	result := LeftJoinByKey(a, b)
+	for k, bv := range b {
+		if _, ok := a[k]; !ok {
+			result[k] = Pair[A, B]{Right: bv, HasRight: true}
+		}
+	}
+	return result
+}
+
+// KeysDiff compares the keys of a and b, which may hold different value types.
+// It returns the keys only in a, the keys only in b, and the keys in both.
+// The order of keys within each slice is not specified.
+func KeysDiff[K comparable, A, B any](a map[K]A, b map[K]B) (onlyA, onlyB, both []K) {
+	for k := range a {
+		if _, ok := b[k]; ok {
+			both = append(both, k)
+		} else {
+			onlyA = append(onlyA, k)
+		}
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			onlyB = append(onlyB, k)
+		}
+	}
+	return onlyA, onlyB, both
+}
@@ -0,0 +1,74 @@
+package record
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+var (
+	joinNames  = map[int]string{1: "alice", 2: "bob", 3: "carol"}
+	joinScores = map[int]float64{2: 7.5, 3: 9.1, 4: 1.0}
+)
+
+func TestZipByKey(t *testing.T) {
+	got := ZipByKey(joinNames, joinScores)
+	expected := map[int]Pair[string, float64]{
+		2: {Left: "bob", Right: 7.5, HasLeft: true, HasRight: true},
+		3: {Left: "carol", Right: 9.1, HasLeft: true, HasRight: true},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestLeftJoinByKey(t *testing.T) {
+	got := LeftJoinByKey(joinNames, joinScores)
+	expected := map[int]Pair[string, float64]{
+		1: {Left: "alice", HasLeft: true},
+		2: {Left: "bob", Right: 7.5, HasLeft: true, HasRight: true},
+		3: {Left: "carol", Right: 9.1, HasLeft: true, HasRight: true},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestOuterJoinByKey(t *testing.T) {
+	got := OuterJoinByKey(joinNames, joinScores)
+	if len(got) != 4 {
+		t.Fatalf("Expected 4 keys, got %v", got)
+	}
+	if p := got[4]; p.HasLeft || !p.HasRight || p.Right != 1.0 {
+		t.Errorf("Unexpected pair for right-only key: %+v", p)
+	}
+	if p := got[1]; !p.HasLeft || p.HasRight {
+		t.Errorf("Unexpected pair for left-only key: %+v", p)
+	}
+
+	if got := OuterJoinByKey[int, string, float64](nil, nil); len(got) != 0 {
+		t.Errorf("Expected empty result, got %v", got)
+	}
+}
+
+func TestKeysDiff(t *testing.T) {
+	onlyA, onlyB, both := KeysDiff(joinNames, joinScores)
+	sort.Ints(onlyA)
+	sort.Ints(onlyB)
+	sort.Ints(both)
+
+	if !reflect.DeepEqual(onlyA, []int{1}) {
+		t.Errorf("Expected onlyA [1], got %v", onlyA)
+	}
+	if !reflect.DeepEqual(onlyB, []int{4}) {
+		t.Errorf("Expected onlyB [4], got %v", onlyB)
+	}
+	if !reflect.DeepEqual(both, []int{2, 3}) {
+		t.Errorf("Expected both [2 3], got %v", both)
+	}
+
+	onlyA, onlyB, both = KeysDiff(map[int]string{}, map[int]bool(nil))
+	if onlyA != nil || onlyB != nil || both != nil {
+		t.Errorf("Expected nil results, got %v %v %v", onlyA, onlyB, both)
+	}
+}
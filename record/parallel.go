@@ -0,0 +1,130 @@
+package record
+
+import (
+	"sync"
+
+	"github.com/cirius-go/devutil/internal/panicerr"
+)
+
+// MapValuesErr transforms the values of a map using a mapper function that may fail.
+// Entries whose mapper fails are omitted from the result, and their errors are
+// returned as a RecordError keyed by the map key.
+func MapValuesErr[K comparable, V, V2 any](m map[K]V, mapper func(V) (V2, error)) (map[K]V2, error) {
+	return MapValuesParallel(m, 1, mapper)
+}
+
+// FilterErr returns a new map containing only the entries that satisfy a predicate that may fail.
+// Entries whose predicate fails are omitted from the result, and their errors are
+// returned as a RecordError keyed by the map key.
+func FilterErr[K comparable, V any](m map[K]V, predicate func(K, V) (bool, error)) (map[K]V, error) {
+	return FilterParallel(m, 1, predicate)
+}
+
+// MapValuesParallel transforms the values of a map using a mapper function that may fail,
+// running up to concurrency mappers at the same time.
+// If concurrency <= 1, values are mapped sequentially.
+// Entries whose mapper fails are omitted from the result, and their errors are
+// returned as a RecordError keyed by the map key. A panicking mapper is reported
+// as a *PanicError for its key.
+func MapValuesParallel[K comparable, V, V2 any](m map[K]V, concurrency int, mapper func(V) (V2, error)) (map[K]V2, error) {
+	if m == nil || mapper == nil {
+		return nil, nil
+	}
+	var (
+		mu     sync.Mutex
+		result = make(map[K]V2, len(m))
+		errs   RecordError[K]
+	)
+	forEachEntry(m, concurrency, func(k K, v V) {
+		var out V2
+		err := safeCall("mapper", func() (err error) {
+			out, err = mapper(v)
+			return err
+		})
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs = append(errs, &KeyError[K]{Key: k, Err: err})
+			return
+		}
+		result[k] = out
+	})
+	if len(errs) == 0 {
+		return result, nil
+	}
+	return result, errs
+}
+
+// FilterParallel returns a new map containing only the entries that satisfy the predicate,
+// running up to concurrency predicates at the same time.
+// If concurrency <= 1, entries are checked sequentially.
+// Entries whose predicate fails are omitted from the result, and their errors are
+// returned as a RecordError keyed by the map key. A panicking predicate is reported
+// as a *PanicError for its key.
+func FilterParallel[K comparable, V any](m map[K]V, concurrency int, predicate func(K, V) (bool, error)) (map[K]V, error) {
+	if m == nil || predicate == nil {
+		return m, nil
+	}
+	var (
+		mu     sync.Mutex
+		result = make(map[K]V)
+		errs   RecordError[K]
+	)
+	forEachEntry(m, concurrency, func(k K, v V) {
+		var ok bool
+		err := safeCall("predicate", func() (err error) {
+			ok, err = predicate(k, v)
+			return err
+		})
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs = append(errs, &KeyError[K]{Key: k, Err: err})
+			return
+		}
+		if ok {
+			result[k] = v
+		}
+	})
+	if len(errs) == 0 {
+		return result, nil
+	}
+	return result, errs
+}
+
+// safeCall runs fn, converting a panic into a *PanicError for the function described by op.
+func safeCall(op string, fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicerr.New(op, -1, r)
+		}
+	}()
+	return fn()
+}
+
+// forEachEntry calls fn for every entry of the map, running up to concurrency calls at the same time.
+// If concurrency <= 1, entries are processed sequentially.
+// It returns once every call has finished.
+func forEachEntry[K comparable, V any](m map[K]V, concurrency int, fn func(K, V)) {
+	if concurrency <= 1 {
+		for k, v := range m {
+			fn(k, v)
+		}
+		return
+	}
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+	for k, v := range m {
+		sem <- struct{}{} // Acquire token
+		wg.Add(1)
+		go func(k K, v V) {
+			defer wg.Done()
+			defer func() { <-sem }() // Release token
+			fn(k, v)
+		}(k, v)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,183 @@
+package record
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMapValuesParallel(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	for _, concurrency := range []int{1, 2} {
+		got, err := MapValuesParallel(m, concurrency, func(v int) (string, error) {
+			return strconv.Itoa(v * 10), nil
+		})
+		if err != nil {
+			t.Errorf("concurrency %d: unexpected error: %v", concurrency, err)
+		}
+		expected := map[string]string{"a": "10", "b": "20", "c": "30"}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("concurrency %d: expected %v, got %v", concurrency, expected, got)
+		}
+	}
+
+	if got, err := MapValuesParallel[string, int, int](nil, 2, nil); got != nil || err != nil {
+		t.Errorf("Expected nil result for nil input, got %v, %v", got, err)
+	}
+}
+
+func TestMapValuesParallel_Errors(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	errBad := errors.New("bad value")
+
+	got, err := MapValuesParallel(m, 3, func(v int) (int, error) {
+		if v == 2 {
+			return 0, errBad
+		}
+		return v, nil
+	})
+
+	expected := map[string]int{"a": 1, "c": 3}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	var recErr RecordError[string]
+	if !errors.As(err, &recErr) {
+		t.Fatalf("Expected RecordError, got %T", err)
+	}
+	if len(recErr) != 1 || recErr[0].Key != "b" {
+		t.Errorf("Expected single error for key b, got %v", recErr)
+	}
+	if !errors.Is(err, errBad) {
+		t.Errorf("Expected error to contain %v", errBad)
+	}
+}
+
+func TestParallel_Panics(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	for _, concurrency := range []int{1, 3} {
+		got, err := MapValuesParallel(m, concurrency, func(v int) (int, error) {
+			if v == 2 {
+				panic("boom")
+			}
+			return v, nil
+		})
+		if expected := map[string]int{"a": 1, "c": 3}; !reflect.DeepEqual(got, expected) {
+			t.Errorf("concurrency %d: expected %v, got %v", concurrency, expected, got)
+		}
+		var recErr RecordError[string]
+		var panicErr *PanicError
+		if !errors.As(err, &recErr) || len(recErr) != 1 || recErr[0].Key != "b" || !errors.As(recErr[0], &panicErr) {
+			t.Errorf("concurrency %d: expected a PanicError for key b, got %v", concurrency, err)
+		} else if panicErr.Error() != "panic in mapper: boom" {
+			t.Errorf("concurrency %d: unexpected message %q", concurrency, panicErr.Error())
+		}
+
+		_, err = FilterParallel(m, concurrency, func(k string, v int) (bool, error) {
+			if k == "c" {
+				panic(errors.New("bad predicate"))
+			}
+			return true, nil
+		})
+		if !errors.As(err, &recErr) || len(recErr) != 1 || recErr[0].Key != "c" || !errors.As(err, &panicErr) {
+			t.Errorf("concurrency %d: expected a PanicError for key c, got %v", concurrency, err)
+		}
+	}
+}
+
+func TestMapValuesParallel_ConcurrencyLimit(t *testing.T) {
+	m := make(map[int]int, 20)
+	for i := 0; i < 20; i++ {
+		m[i] = i
+	}
+	var active, peak int32
+	_, err := MapValuesParallel(m, 3, func(v int) (int, error) {
+		cur := atomic.AddInt32(&active, 1)
+		for {
+			old := atomic.LoadInt32(&peak)
+			if cur <= old || atomic.CompareAndSwapInt32(&peak, old, cur) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&active, -1)
+		return v, nil
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if peak > 3 {
+		t.Errorf("Expected at most 3 concurrent mappers, got %d", peak)
+	}
+}
+
+func TestFilterParallel(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+	errBad := errors.New("bad key")
+
+	got, err := FilterParallel(m, 2, func(k string, v int) (bool, error) {
+		if k == "d" {
+			return false, errBad
+		}
+		return v%2 != 0, nil
+	})
+
+	expected := map[string]int{"a": 1, "c": 3}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if !errors.Is(err, errBad) {
+		t.Errorf("Expected error to contain %v, got %v", errBad, err)
+	}
+}
+
+func TestMapValuesErr(t *testing.T) {
+	m := map[string]string{"a": "1", "b": "x", "c": "3"}
+
+	got, err := MapValuesErr(m, strconv.Atoi)
+	expected := map[string]int{"a": 1, "c": 3}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	var recErr RecordError[string]
+	if !errors.As(err, &recErr) || len(recErr) != 1 || recErr[0].Key != "b" {
+		t.Fatalf("Expected RecordError for key b, got %v", err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Expected error to wrap strconv.ErrSyntax, got %v", err)
+	}
+}
+
+func TestFilterErr(t *testing.T) {
+	m := map[int]string{1: "keep", 2: "drop", 3: "fail"}
+	errFail := errors.New("lookup failed")
+
+	got, err := FilterErr(m, func(k int, v string) (bool, error) {
+		if v == "fail" {
+			return false, errFail
+		}
+		return v == "keep", nil
+	})
+	expected := map[int]string{1: "keep"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	var recErr RecordError[int]
+	if !errors.As(err, &recErr) || len(recErr) != 1 || recErr[0].Key != 3 {
+		t.Fatalf("Expected RecordError for key 3, got %v", err)
+	}
+	if !errors.Is(err, errFail) {
+		t.Errorf("Expected error to wrap errFail, got %v", err)
+	}
+
+	if got, err := FilterErr(map[int]string{1: "keep"}, func(int, string) (bool, error) { return true, nil }); err != nil || len(got) != 1 {
+		t.Errorf("Expected no error, got %v, %v", got, err)
+	}
+}
@@ -0,0 +1,249 @@
+package record
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// PathOption configures how GetPath, SetPath and DeletePath interpret a path.
+type PathOption func(*pathOptions)
+
+// pathOptions holds the settings configured by PathOption values.
+type pathOptions struct {
+	separator string
+}
+
+// WithSeparator sets the separator between path segments. The default is ".".
+func WithSeparator(sep string) PathOption {
+	return func(o *pathOptions) {
+		o.separator = sep
+	}
+}
+
+// splitPath splits path into segments according to opts.
+func splitPath(path string, opts []PathOption) []string {
+	o := pathOptions{separator: "."}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	if o.separator == "" {
+		return []string{path}
+	}
+	return strings.Split(path, o.separator)
+}
+
+// GetPath returns the value found by following path through nested maps and slices,
+// such as those produced by decoding JSON into map[string]any.
+// Segments address map[string]any keys or []any indexes, e.g. "items.0.id".
+// The bool is false if any segment cannot be resolved.
+func GetPath(m map[string]any, path string, opts ...PathOption) (any, bool) {
+	var cur any = m
+	for _, seg := range splitPath(path, opts) {
+		switch c := cur.(type) {
+		case map[string]any:
+			v, ok := c[seg]
+			if !ok {
+				return nil, false
+			}
+			cur = v
+		case []any:
+			i, err := strconv.Atoi(seg)
+			if err != nil || i < 0 || i >= len(c) {
+				return nil, false
+			}
+			cur = c[i]
+		default:
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+// Project builds a new map by picking and renaming values in one pass: spec maps a
+// source path in m, resolved as by GetPath, to the key it is stored under in the result.
+// Paths that cannot be resolved are left out. Values are not copied.
+// Source paths are resolved in sorted order, so when several map to the same key,
+// the last one in sorted order that resolves wins. Returns nil if m is nil.
+func Project(m map[string]any, spec map[string]string, opts ...PathOption) map[string]any {
+	if m == nil {
+		return nil
+	}
+	result := make(map[string]any, len(spec))
+	for _, src := range SortedKeys(spec) {
+		if v, ok := GetPath(m, src, opts...); ok {
+			result[spec[src]] = v
+		}
+	}
+	return result
+}
+
+// SetPath stores value at path, creating intermediate maps for missing keys.
+// Slice segments must address an existing index. It returns an error if m is nil,
+// an index is invalid, or an intermediate value is neither a map[string]any nor an []any.
+func SetPath(m map[string]any, path string, value any, opts ...PathOption) error {
+	if m == nil {
+		return fmt.Errorf("record: cannot set path %q in nil map", path)
+	}
+	_, err := setPath(m, splitPath(path, opts), value)
+	if err != nil {
+		return fmt.Errorf("record: cannot set path %q: %w", path, err)
+	}
+	return nil
+}
+
+// setPath stores value at segs inside cur and returns the updated container.
+func setPath(cur any, segs []string, value any) (any, error) {
+	seg, last := segs[0], len(segs) == 1
+	switch c := cur.(type) {
+	case map[string]any:
+		if last {
+			c[seg] = value
+			return c, nil
+		}
+		child, ok := c[seg]
+		if !ok || child == nil {
+			child = make(map[string]any)
+		}
+		updated, err := setPath(child, segs[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		c[seg] = updated
+		return c, nil
+	case []any:
+		i, err := strconv.Atoi(seg)
+		if err != nil || i < 0 || i >= len(c) {
+			return nil, fmt.Errorf("invalid index %q for slice of length %d", seg, len(c))
+		}
+		if last {
+			c[i] = value
+			return c, nil
+		}
+		updated, err := setPath(c[i], segs[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		c[i] = updated
+		return c, nil
+	}
+	return nil, fmt.Errorf("segment %q: cannot traverse %T", seg, cur)
+}
+
+// DeletePath removes the value at path and reports whether it was present.
+// Deleting a slice element removes it from the slice, shifting later elements down.
+func DeletePath(m map[string]any, path string, opts ...PathOption) bool {
+	if m == nil {
+		return false
+	}
+	_, ok := deletePath(m, splitPath(path, opts))
+	return ok
+}
+
+// deletePath removes the value at segs inside cur and returns the updated container.
+func deletePath(cur any, segs []string) (any, bool) {
+	seg, last := segs[0], len(segs) == 1
+	switch c := cur.(type) {
+	case map[string]any:
+		child, ok := c[seg]
+		if !ok {
+			return c, false
+		}
+		if last {
+			delete(c, seg)
+			return c, true
+		}
+		updated, ok := deletePath(child, segs[1:])
+		if ok {
+			c[seg] = updated
+		}
+		return c, ok
+	case []any:
+		i, err := strconv.Atoi(seg)
+		if err != nil || i < 0 || i >= len(c) {
+			return c, false
+		}
+		if last {
+			return append(slices.Clone(c[:i]), c[i+1:]...), true
+		}
+		updated, ok := deletePath(c[i], segs[1:])
+		if ok {
+			c[i] = updated
+		}
+		return c, ok
+	}
+	return cur, false
+}
+
+// WalkAction tells Walk what to do with a visited value.
+type WalkAction int
+
+const (
+	// WalkContinue keeps the value and walks into it if it is a map or slice.
+	WalkContinue WalkAction = iota
+	// WalkReplace replaces the value with the replacement returned by the visitor.
+	// The replacement is not walked.
+	WalkReplace
+	// WalkDelete removes the value from its map or slice.
+	WalkDelete
+	// WalkSkip keeps the value but does not walk into it.
+	WalkSkip
+)
+
+// Walk visits every value nested in m, depth-first, calling visit with the path to
+// each value and the value itself. Map keys are visited in sorted order and slice
+// indexes are given as decimal strings, matching GetPath segments.
+// The action returned by visit can replace or delete the value, or skip its subtree;
+// m is modified in place. Nested maps must be map[string]any and slices []any to be
+// walked into. The path slice is reused between calls; clone it to retain it.
+func Walk(m map[string]any, visit func(path []string, value any) (replace any, action WalkAction)) {
+	if m == nil || visit == nil {
+		return
+	}
+	walkMap(m, make([]string, 0, 8), visit)
+}
+
+// walkMap walks the entries of m, whose path is path.
+func walkMap(m map[string]any, path []string, visit func([]string, any) (any, WalkAction)) {
+	for _, k := range SortedKeys(m) {
+		p := append(path, k)
+		v, keep := walkValue(m[k], p, visit)
+		if keep {
+			m[k] = v
+		} else {
+			delete(m, k)
+		}
+	}
+}
+
+// walkValue visits v at path and walks into it. It returns the value to store and
+// false if the value should be removed.
+func walkValue(v any, path []string, visit func([]string, any) (any, WalkAction)) (any, bool) {
+	replace, action := visit(path, v)
+	switch action {
+	case WalkReplace:
+		return replace, true
+	case WalkDelete:
+		return nil, false
+	case WalkSkip:
+		return v, true
+	}
+	switch c := v.(type) {
+	case map[string]any:
+		walkMap(c, path, visit)
+	case []any:
+		kept := c[:0]
+		for i, item := range c {
+			p := append(path, strconv.Itoa(i))
+			if item, keep := walkValue(item, p, visit); keep {
+				kept = append(kept, item)
+			}
+		}
+		clear(c[len(kept):])
+		return kept, true
+	}
+	return v, true
+}
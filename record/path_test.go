@@ -0,0 +1,180 @@
+package record
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func decodeJSON(t *testing.T, s string) map[string]any {
+	t.Helper()
+	var m map[string]any
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		t.Fatalf("invalid test JSON: %v", err)
+	}
+	return m
+}
+
+func TestGetPath(t *testing.T) {
+	m := decodeJSON(t, `{"user": {"name": "alice"}, "items": [{"id": 1}, {"id": 2}]}`)
+
+	tests := []struct {
+		path   string
+		want   any
+		wantOK bool
+	}{
+		{"user.name", "alice", true},
+		{"items.1.id", float64(2), true},
+		{"items.2.id", nil, false},
+		{"items.x", nil, false},
+		{"user.name.first", nil, false},
+		{"missing", nil, false},
+	}
+	for _, tt := range tests {
+		got, ok := GetPath(m, tt.path)
+		if ok != tt.wantOK || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("GetPath(%q) = (%v, %v), want (%v, %v)", tt.path, got, ok, tt.want, tt.wantOK)
+		}
+	}
+
+	if got, ok := GetPath(m, "items/0/id", WithSeparator("/")); !ok || got != float64(1) {
+		t.Errorf("GetPath with custom separator = (%v, %v)", got, ok)
+	}
+}
+
+func TestProject(t *testing.T) {
+	m := decodeJSON(t, `{"user": {"name": "alice", "email": "a@x.io"}, "items": [{"id": 1}], "secret": "s"}`)
+	got := Project(m, map[string]string{
+		"user.name":  "name",
+		"items.0.id": "first_item",
+		"user.phone": "phone",
+	})
+	want := map[string]any{"name": "alice", "first_item": float64(1)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	got = Project(m, map[string]string{"user/email": "email"}, WithSeparator("/"))
+	if !reflect.DeepEqual(got, map[string]any{"email": "a@x.io"}) {
+		t.Errorf("Project with custom separator = %v", got)
+	}
+
+	// Colliding destinations resolve deterministically: the last source path in sorted order wins.
+	for range 20 {
+		got = Project(m, map[string]string{"user.email": "contact", "user.name": "contact", "user.phone": "contact"})
+		if !reflect.DeepEqual(got, map[string]any{"contact": "alice"}) {
+			t.Fatalf("Project with colliding keys = %v", got)
+		}
+	}
+
+	if got := Project(nil, map[string]string{"a": "b"}); got != nil {
+		t.Errorf("Expected nil for nil map, got %v", got)
+	}
+}
+
+func TestSetPath(t *testing.T) {
+	m := decodeJSON(t, `{"items": [{"id": 1}]}`)
+
+	if err := SetPath(m, "user.address.city", "Hue"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := SetPath(m, "items.0.id", 10); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := map[string]any{
+		"user":  map[string]any{"address": map[string]any{"city": "Hue"}},
+		"items": []any{map[string]any{"id": 10}},
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Errorf("Expected %v, got %v", expected, m)
+	}
+
+	if err := SetPath(m, "items.5.id", 1); err == nil {
+		t.Error("Expected error for out of range index")
+	}
+	if err := SetPath(m, "user.address.city.zip", 1); err == nil {
+		t.Error("Expected error when traversing a scalar")
+	}
+	if err := SetPath(nil, "a", 1); err == nil {
+		t.Error("Expected error for nil map")
+	}
+}
+
+func TestDeletePath(t *testing.T) {
+	m := decodeJSON(t, `{"user": {"name": "alice", "age": 30}, "tags": ["a", "b", "c"]}`)
+
+	if !DeletePath(m, "user.age") {
+		t.Error("Expected user.age to be deleted")
+	}
+	if !DeletePath(m, "tags.1") {
+		t.Error("Expected tags.1 to be deleted")
+	}
+	if DeletePath(m, "user.missing") || DeletePath(m, "tags.9") {
+		t.Error("Expected missing paths not to be deleted")
+	}
+	expected := map[string]any{
+		"user": map[string]any{"name": "alice"},
+		"tags": []any{"a", "c"},
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Errorf("Expected %v, got %v", expected, m)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	m := decodeJSON(t, `{
+		"user": {"name": "alice", "password": "secret", "tokens": ["t1", "t2"]},
+		"items": [{"id": 1, "debug": true}, {"id": 2}],
+		"raw": {"password": "keep"}
+	}`)
+
+	var visited []string
+	Walk(m, func(path []string, value any) (any, WalkAction) {
+		visited = append(visited, strings.Join(path, "."))
+		switch path[len(path)-1] {
+		case "raw":
+			return nil, WalkSkip
+		case "password":
+			return "***", WalkReplace
+		case "debug":
+			return nil, WalkDelete
+		}
+		if strings.Join(path, ".") == "user.tokens.0" {
+			return nil, WalkDelete
+		}
+		return nil, WalkContinue
+	})
+
+	expected := map[string]any{
+		"user":  map[string]any{"name": "alice", "password": "***", "tokens": []any{"t2"}},
+		"items": []any{map[string]any{"id": float64(1)}, map[string]any{"id": float64(2)}},
+		"raw":   map[string]any{"password": "keep"},
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Errorf("Expected %v, got %v", expected, m)
+	}
+
+	expectedPaths := []string{
+		"items", "items.0", "items.0.debug", "items.0.id", "items.1", "items.1.id",
+		"raw",
+		"user", "user.name", "user.password", "user.tokens", "user.tokens.0", "user.tokens.1",
+	}
+	if !reflect.DeepEqual(visited, expectedPaths) {
+		t.Errorf("Expected paths %v, got %v", expectedPaths, visited)
+	}
+}
+
+func TestWalk_ReplaceIsNotWalked(t *testing.T) {
+	m := map[string]any{"a": map[string]any{"b": 1}}
+	calls := 0
+	Walk(m, func(path []string, value any) (any, WalkAction) {
+		calls++
+		return map[string]any{"c": 2}, WalkReplace
+	})
+	if calls != 1 || !reflect.DeepEqual(m, map[string]any{"a": map[string]any{"c": 2}}) {
+		t.Errorf("Unexpected result %v after %d calls", m, calls)
+	}
+
+	Walk(nil, func([]string, any) (any, WalkAction) { t.Error("visited nil map"); return nil, WalkContinue })
+}
@@ -5,6 +5,68 @@ import (
 	"slices"
 )
 
+// Entry is a key-value pair of a map.
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// IsEmpty returns true if the map is nil or has no entries.
+func IsEmpty[K comparable, V any](m map[K]V) bool {
+	return len(m) == 0
+}
+
+// NotEmpty returns true if the map has at least one entry.
+func NotEmpty[K comparable, V any](m map[K]V) bool {
+	return len(m) > 0
+}
+
+// Clear deletes all entries from the map in place, keeping its allocated capacity
+// so it can be reused.
+func Clear[K comparable, V any](m map[K]V) {
+	clear(m)
+}
+
+// Drain deletes all entries from the map in place and returns them.
+// The order of entries is not guaranteed. Returns nil if the map is empty.
+func Drain[K comparable, V any](m map[K]V) []Entry[K, V] {
+	if len(m) == 0 {
+		return nil
+	}
+	entries := make([]Entry[K, V], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	}
+	clear(m)
+	return entries
+}
+
+// FromPairs builds a map from key-value pairs. Later pairs overwrite earlier ones with the same key.
+func FromPairs[K comparable, V any](pairs []Entry[K, V]) map[K]V {
+	result := make(map[K]V, len(pairs))
+	for _, p := range pairs {
+		result[p.Key] = p.Value
+	}
+	return result
+}
+
+// ToPairs returns the entries of the map as key-value pairs.
+// The order of pairs is not guaranteed; use SortedToPairs for a deterministic order.
+func ToPairs[K comparable, V any](m map[K]V) []Entry[K, V] {
+	pairs := make([]Entry[K, V], 0, len(m))
+	for k, v := range m {
+		pairs = append(pairs, Entry[K, V]{Key: k, Value: v})
+	}
+	return pairs
+}
+
+// SortedToPairs returns the entries of the map as key-value pairs, sorted by key.
+func SortedToPairs[K cmp.Ordered, V any](m map[K]V) []Entry[K, V] {
+	pairs := ToPairs(m)
+	slices.SortFunc(pairs, func(a, b Entry[K, V]) int { return cmp.Compare(a.Key, b.Key) })
+	return pairs
+}
+
 // Keys returns a slice of keys from the map.
 // The order of keys is not guaranteed.
 func Keys[K comparable, V any](m map[K]V) []K {
@@ -25,6 +87,77 @@ func Values[K comparable, V any](m map[K]V) []V {
 	return values
 }
 
+// KeysWhere returns a slice of keys whose entries satisfy the predicate.
+// The order of keys is not guaranteed.
+func KeysWhere[K comparable, V any](m map[K]V, predicate func(K, V) bool) []K {
+	if predicate == nil {
+		return Keys(m)
+	}
+	var keys []K
+	for k, v := range m {
+		if predicate(k, v) {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// ValuesWhere returns a slice of values whose entries satisfy the predicate.
+// The order of values is not guaranteed.
+func ValuesWhere[K comparable, V any](m map[K]V, predicate func(K, V) bool) []V {
+	if predicate == nil {
+		return Values(m)
+	}
+	var values []V
+	for k, v := range m {
+		if predicate(k, v) {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// MapToSlice transforms each entry of the map into an element of a new slice.
+// The order of elements is not guaranteed.
+func MapToSlice[K comparable, V, T any](m map[K]V, transform func(K, V) T) []T {
+	if m == nil || transform == nil {
+		return nil
+	}
+	result := make([]T, 0, len(m))
+	for k, v := range m {
+		result = append(result, transform(k, v))
+	}
+	return result
+}
+
+// SortedMapToSlice transforms each entry of the map into a slice element, in the key
+// order given by less, for deterministic output. Returns nil if m or transform is nil.
+// If less is nil, the elements are left in unspecified order, as with MapToSlice.
+func SortedMapToSlice[K comparable, V, T any](m map[K]V, less func(a, b K) bool, transform func(K, V) T) []T {
+	if m == nil || transform == nil {
+		return nil
+	}
+	if less == nil {
+		return MapToSlice(m, transform)
+	}
+	keys := Keys(m)
+	slices.SortFunc(keys, func(a, b K) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	})
+	result := make([]T, len(keys))
+	for i, k := range keys {
+		result[i] = transform(k, m[k])
+	}
+	return result
+}
+
 // Clone creates a shallow copy of the map.
 func Clone[K comparable, V any](m map[K]V) map[K]V {
 	if m == nil {
@@ -53,6 +186,136 @@ func Merge[K comparable, V any](maps ...map[K]V) map[K]V {
 	return result
 }
 
+// MergeWith merges multiple maps into a new map, using resolve to combine values
+// when a key is present in more than one map.
+// resolve receives the key, the value merged so far and the value from the later map.
+// If resolve is nil, later maps override earlier ones like Merge.
+func MergeWith[K comparable, V any](resolve func(key K, old, new V) V, maps ...map[K]V) map[K]V {
+	if resolve == nil {
+		return Merge(maps...)
+	}
+	size := 0
+	for _, m := range maps {
+		size += len(m)
+	}
+	result := make(map[K]V, size)
+	for _, m := range maps {
+		for k, v := range m {
+			if old, ok := result[k]; ok {
+				v = resolve(k, old, v)
+			}
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// Assign copies the entries of every source map into dst, in place.
+// Keys from later maps override keys from earlier maps and from dst.
+// dst must not be nil unless all sources are empty.
+func Assign[K comparable, V any](dst map[K]V, srcs ...map[K]V) {
+	for _, m := range srcs {
+		for k, v := range m {
+			dst[k] = v
+		}
+	}
+}
+
+// MergeInto copies the entries of every source map into the map dst points to, like
+// Assign, allocating it first if it is nil. It does nothing if dst is a nil pointer.
+func MergeInto[K comparable, V any](dst *map[K]V, srcs ...map[K]V) {
+	if dst == nil {
+		return
+	}
+	if *dst == nil {
+		*dst = make(map[K]V)
+	}
+	Assign(*dst, srcs...)
+}
+
+// MergeReport lists the keys changed by MergeIntoReport.
+type MergeReport[K comparable] struct {
+	// Added holds the keys that were not in the destination before the merge.
+	Added []K
+	// Overwritten holds the keys of the destination whose values were replaced.
+	Overwritten []K
+}
+
+// MergeIntoReport is like MergeInto, and also reports which keys were added and which
+// were overwritten. Each key is reported once, relative to the destination before the
+// merge. The order of keys within each list is not guaranteed.
+// If dst is a nil pointer, nothing is merged and the report is empty.
+func MergeIntoReport[K comparable, V any](dst *map[K]V, srcs ...map[K]V) MergeReport[K] {
+	var report MergeReport[K]
+	if dst == nil {
+		return report
+	}
+	if *dst == nil {
+		*dst = make(map[K]V)
+	}
+	m := *dst
+	seen := make(map[K]struct{})
+	for _, src := range srcs {
+		for k, v := range src {
+			if _, ok := seen[k]; !ok {
+				seen[k] = struct{}{}
+				if _, existed := m[k]; existed {
+					report.Overwritten = append(report.Overwritten, k)
+				} else {
+					report.Added = append(report.Added, k)
+				}
+			}
+			m[k] = v
+		}
+	}
+	return report
+}
+
+// AssignMissing copies into dst, in place, only the entries whose keys are absent from dst.
+// Among the sources, the first map that has a key wins.
+// dst must not be nil unless all sources are empty.
+func AssignMissing[K comparable, V any](dst map[K]V, srcs ...map[K]V) {
+	for _, m := range srcs {
+		for k, v := range m {
+			if _, ok := dst[k]; !ok {
+				dst[k] = v
+			}
+		}
+	}
+}
+
+// ApplyDefaults returns a new map with the entries of m, plus every entry of defaults
+// whose key is missing from m. Neither input is modified.
+func ApplyDefaults[K comparable, V any](m, defaults map[K]V) map[K]V {
+	result := make(map[K]V, max(len(m), len(defaults)))
+	for k, v := range defaults {
+		result[k] = v
+	}
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+// ApplyDefaultsFunc returns a new map with the entries of m, plus a default for each
+// of keys that is missing from m. provider is called only for missing keys; if it
+// returns false, the key is left unset.
+func ApplyDefaultsFunc[K comparable, V any](m map[K]V, keys []K, provider func(key K) (V, bool)) map[K]V {
+	result := make(map[K]V, len(m)+len(keys))
+	for k, v := range m {
+		result[k] = v
+	}
+	for _, k := range keys {
+		if _, ok := result[k]; ok {
+			continue
+		}
+		if v, ok := provider(k); ok {
+			result[k] = v
+		}
+	}
+	return result
+}
+
 // Filter returns a new map containing only the entries that satisfy the predicate.
 func Filter[K comparable, V any](m map[K]V, predicate func(K, V) bool) map[K]V {
 	if m == nil {
@@ -79,6 +342,136 @@ func MapValues[K comparable, InV, OutV any](m map[K]InV, mapper func(InV) OutV)
 	return result
 }
 
+// RemapKeys returns a new map with keys renamed according to the aliases table.
+// Keys without an alias are kept as is. If several keys end up with the same name,
+// an entry whose key was not renamed takes precedence over renamed ones, and among
+// renamed keys the smallest source key wins, so the outcome is deterministic.
+func RemapKeys[K cmp.Ordered, V any](m map[K]V, aliases map[K]K) map[K]V {
+	if m == nil {
+		return nil
+	}
+	result := make(map[K]V, len(m))
+	renamedTo := make(map[K]struct{})
+	for _, k := range SortedKeys(m) {
+		v := m[k]
+		alias, ok := aliases[k]
+		if !ok {
+			result[k] = v
+			continue
+		}
+		if _, exists := m[alias]; exists {
+			if _, renamed := aliases[alias]; !renamed {
+				continue
+			}
+		}
+		if _, taken := renamedTo[alias]; taken {
+			continue
+		}
+		renamedTo[alias] = struct{}{}
+		result[alias] = v
+	}
+	return result
+}
+
+// RenameKey moves the value stored under oldKey to newKey in place, overwriting any
+// existing value for newKey. It reports whether oldKey was present.
+func RenameKey[K comparable, V any](m map[K]V, oldKey, newKey K) bool {
+	v, ok := m[oldKey]
+	if !ok {
+		return false
+	}
+	delete(m, oldKey)
+	m[newKey] = v
+	return true
+}
+
+// CanonicalizeKeys returns a new map with every key passed through canon, e.g.
+// strings.ToLower for header maps. When several keys canonicalize to the same key,
+// resolve combines their values; it receives the canonical key, the value combined so
+// far and the next value, with source keys taken in sorted order so the outcome is
+// deterministic. If resolve is nil, the value of the smallest source key is kept.
+func CanonicalizeKeys[V any](m map[string]V, canon func(key string) string, resolve func(key string, old, new V) V) map[string]V {
+	if m == nil {
+		return nil
+	}
+	result := make(map[string]V, len(m))
+	for _, k := range SortedKeys(m) {
+		ck := canon(k)
+		old, ok := result[ck]
+		switch {
+		case !ok:
+			result[ck] = m[k]
+		case resolve != nil:
+			result[ck] = resolve(ck, old, m[k])
+		}
+	}
+	return result
+}
+
+// Transpose pivots a nested map so that inner keys become outer keys and vice versa,
+// e.g. metric[service][region] becomes metric[region][service].
+func Transpose[K1, K2 comparable, V any](m map[K1]map[K2]V) map[K2]map[K1]V {
+	if m == nil {
+		return nil
+	}
+	result := make(map[K2]map[K1]V)
+	for k1, inner := range m {
+		for k2, v := range inner {
+			row, ok := result[k2]
+			if !ok {
+				row = make(map[K1]V)
+				result[k2] = row
+			}
+			row[k1] = v
+		}
+	}
+	return result
+}
+
+// Invert returns a new map with keys and values swapped.
+// If several keys share a value, which of them is kept is not specified; use InvertToSets to keep them all.
+func Invert[K, V comparable](m map[K]V) map[V]K {
+	if m == nil {
+		return nil
+	}
+	result := make(map[V]K, len(m))
+	for k, v := range m {
+		result[v] = k
+	}
+	return result
+}
+
+// InvertToSets returns a reverse index of the map: each value maps to the set of keys that hold it.
+// Sets use the same map[K]struct{} representation as ToSet.
+func InvertToSets[K, V comparable](m map[K]V) map[V]map[K]struct{} {
+	if m == nil {
+		return nil
+	}
+	result := make(map[V]map[K]struct{})
+	for k, v := range m {
+		set, ok := result[v]
+		if !ok {
+			set = make(map[K]struct{})
+			result[v] = set
+		}
+		set[k] = struct{}{}
+	}
+	return result
+}
+
+// GroupKeysByValue groups the keys of the map by their value.
+// The order of keys within each group is not guaranteed. Returns nil if m is nil.
+func GroupKeysByValue[K, V comparable](m map[K]V) map[V][]K {
+	if m == nil {
+		return nil
+	}
+	result := make(map[V][]K)
+	for k, v := range m {
+		result[v] = append(result[v], k)
+	}
+	return result
+}
+
 // ToSet creates a map where the keys are the elements of the slice and values are struct{}{}.
 func ToSet[K comparable](input []K) map[K]struct{} {
 	if input == nil {
@@ -117,3 +510,44 @@ func SortedValues[K comparable, V cmp.Ordered](m map[K]V) []V {
 	slices.Sort(values)
 	return values
 }
+
+// KeysSortedByValue returns the keys of the map ordered by their values, using less to compare values.
+// The order of keys with equal values is not specified. If less is nil, the keys are
+// returned unsorted, as with Keys.
+func KeysSortedByValue[K comparable, V any](m map[K]V, less func(a, b V) bool) []K {
+	keys := Keys(m)
+	if less == nil {
+		return keys
+	}
+	slices.SortFunc(keys, func(a, b K) int {
+		switch {
+		case less(m[a], m[b]):
+			return -1
+		case less(m[b], m[a]):
+			return 1
+		default:
+			return 0
+		}
+	})
+	return keys
+}
+
+// KeysSortedByValueAsc returns the keys of the map ordered by ascending value.
+// The order of keys with equal values is not specified.
+func KeysSortedByValueAsc[K comparable, V cmp.Ordered](m map[K]V) []K {
+	keys := Keys(m)
+	slices.SortFunc(keys, func(a, b K) int {
+		return cmp.Compare(m[a], m[b])
+	})
+	return keys
+}
+
+// KeysSortedByValueDesc returns the keys of the map ordered by descending value.
+// The order of keys with equal values is not specified.
+func KeysSortedByValueDesc[K comparable, V cmp.Ordered](m map[K]V) []K {
+	keys := Keys(m)
+	slices.SortFunc(keys, func(a, b K) int {
+		return cmp.Compare(m[b], m[a])
+	})
+	return keys
+}
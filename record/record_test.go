@@ -1,11 +1,91 @@
 package record
 
 import (
+	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 )
 
+func TestIsEmpty(t *testing.T) {
+	if !IsEmpty[string, int](nil) || !IsEmpty(map[string]int{}) {
+		t.Error("Expected nil and empty maps to be empty")
+	}
+	if IsEmpty(map[string]int{"a": 1}) || !NotEmpty(map[string]int{"a": 1}) {
+		t.Error("Expected non-empty map not to be empty")
+	}
+	if NotEmpty[string, int](nil) {
+		t.Error("Expected nil map not to be NotEmpty")
+	}
+}
+
+func TestClear(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	Clear(m)
+	if len(m) != 0 {
+		t.Errorf("Expected empty map, got %v", m)
+	}
+	m["c"] = 3 // still usable
+}
+
+func TestDrain(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	entries := Drain(m)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+	expected := []Entry[string, int]{{"a", 1}, {"b", 2}}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Errorf("Expected %v, got %v", expected, entries)
+	}
+	if len(m) != 0 {
+		t.Errorf("Expected map to be drained, got %v", m)
+	}
+	if Drain(m) != nil {
+		t.Error("Expected nil when draining an empty map")
+	}
+}
+
+func TestFromPairs(t *testing.T) {
+	m := FromPairs([]Entry[string, int]{{"a", 1}, {"b", 2}, {"a", 3}})
+	expected := map[string]int{"a": 3, "b": 2}
+	if !reflect.DeepEqual(m, expected) {
+		t.Errorf("Expected %v, got %v", expected, m)
+	}
+	if m := FromPairs[string, int](nil); m == nil || len(m) != 0 {
+		t.Errorf("Expected empty non-nil map, got %v", m)
+	}
+}
+
+func TestToPairs(t *testing.T) {
+	m := map[string]int{"b": 2, "a": 1, "c": 3}
+	pairs := ToPairs(m)
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].Key < pairs[j].Key })
+	expected := []Entry[string, int]{{"a", 1}, {"b", 2}, {"c", 3}}
+	if !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("Expected %v, got %v", expected, pairs)
+	}
+	if !reflect.DeepEqual(FromPairs(pairs), m) {
+		t.Errorf("Expected round trip to return %v", m)
+	}
+
+	// Keys that are comparable but not ordered are supported.
+	type point struct{ X, Y int }
+	if got := ToPairs(map[point]bool{{1, 2}: true}); !reflect.DeepEqual(got, []Entry[point, bool]{{point{1, 2}, true}}) {
+		t.Errorf("Expected one pair, got %v", got)
+	}
+}
+
+func TestSortedToPairs(t *testing.T) {
+	m := map[string]int{"b": 2, "a": 1, "c": 3}
+	expected := []Entry[string, int]{{"a", 1}, {"b", 2}, {"c", 3}}
+	if got := SortedToPairs(m); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if got := SortedToPairs(map[string]int(nil)); len(got) != 0 {
+		t.Errorf("Expected no pairs, got %v", got)
+	}
+}
+
 func TestKeys(t *testing.T) {
 	m := map[string]int{"a": 1, "b": 2}
 	keys := Keys(m)
@@ -26,6 +106,61 @@ func TestValues(t *testing.T) {
 	}
 }
 
+func TestKeysWhere(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	keys := KeysWhere(m, func(k string, v int) bool { return v > 1 })
+	sort.Strings(keys)
+	expected := []string{"b", "c"}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Expected %v, got %v", expected, keys)
+	}
+}
+
+func TestValuesWhere(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	vals := ValuesWhere(m, func(k string, v int) bool { return k != "b" })
+	sort.Ints(vals)
+	expected := []int{1, 3}
+	if !reflect.DeepEqual(vals, expected) {
+		t.Errorf("Expected %v, got %v", expected, vals)
+	}
+}
+
+func TestMapToSlice(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	pairs := MapToSlice(m, func(k string, v int) string {
+		return fmt.Sprintf("%s=%d", k, v)
+	})
+	sort.Strings(pairs)
+	expected := []string{"a=1", "b=2"}
+	if !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("Expected %v, got %v", expected, pairs)
+	}
+	if MapToSlice[string, int, string](nil, nil) != nil {
+		t.Error("Expected nil result for nil input")
+	}
+}
+
+func TestSortedMapToSlice(t *testing.T) {
+	m := map[string]int{"b": 2, "c": 3, "a": 1}
+	format := func(k string, v int) string { return fmt.Sprintf("%s=%d", k, v) }
+
+	lines := SortedMapToSlice(m, func(a, b string) bool { return a > b }, format)
+	expected := []string{"c=3", "b=2", "a=1"}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("Expected %v, got %v", expected, lines)
+	}
+	if SortedMapToSlice[string, int, string](nil, nil, format) != nil {
+		t.Error("Expected nil result for nil input")
+	}
+
+	unsorted := SortedMapToSlice(m, nil, format)
+	sort.Strings(unsorted)
+	if expected := []string{"a=1", "b=2", "c=3"}; !reflect.DeepEqual(unsorted, expected) {
+		t.Errorf("Expected %v for nil less, got %v", expected, unsorted)
+	}
+}
+
 func TestClone(t *testing.T) {
 	m := map[string]int{"a": 1}
 	clone := Clone(m)
@@ -48,6 +183,111 @@ func TestMerge(t *testing.T) {
 	}
 }
 
+func TestMergeWith(t *testing.T) {
+	m1 := map[string]int{"a": 1, "b": 2}
+	m2 := map[string]int{"b": 3, "c": 4}
+	m3 := map[string]int{"b": 5}
+	sum := func(_ string, old, new int) int { return old + new }
+	merged := MergeWith(sum, m1, m2, m3)
+	expected := map[string]int{"a": 1, "b": 10, "c": 4}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Errorf("Expected %v, got %v", expected, merged)
+	}
+
+	lastWins := MergeWith(nil, m1, m2)
+	expected = map[string]int{"a": 1, "b": 3, "c": 4}
+	if !reflect.DeepEqual(lastWins, expected) {
+		t.Errorf("Expected %v, got %v", expected, lastWins)
+	}
+}
+
+func TestAssign(t *testing.T) {
+	dst := map[string]int{"a": 1, "b": 2}
+	Assign(dst, map[string]int{"b": 3, "c": 4}, map[string]int{"c": 5})
+	expected := map[string]int{"a": 1, "b": 3, "c": 5}
+	if !reflect.DeepEqual(dst, expected) {
+		t.Errorf("Expected %v, got %v", expected, dst)
+	}
+}
+
+func TestMergeInto(t *testing.T) {
+	var dst map[string]int
+	MergeInto(&dst, map[string]int{"a": 1}, map[string]int{"a": 2, "b": 3})
+	expected := map[string]int{"a": 2, "b": 3}
+	if !reflect.DeepEqual(dst, expected) {
+		t.Errorf("Expected %v, got %v", expected, dst)
+	}
+
+	// A nil pointer is ignored rather than dereferenced.
+	MergeInto(nil, map[string]int{"a": 1})
+}
+
+func TestMergeIntoReport(t *testing.T) {
+	dst := map[string]int{"a": 1, "b": 2}
+	report := MergeIntoReport(&dst, map[string]int{"b": 20, "c": 3}, map[string]int{"c": 30, "d": 4})
+	sort.Strings(report.Added)
+	sort.Strings(report.Overwritten)
+
+	if expected := map[string]int{"a": 1, "b": 20, "c": 30, "d": 4}; !reflect.DeepEqual(dst, expected) {
+		t.Errorf("Expected %v, got %v", expected, dst)
+	}
+	if !reflect.DeepEqual(report.Added, []string{"c", "d"}) {
+		t.Errorf("Expected added [c d], got %v", report.Added)
+	}
+	if !reflect.DeepEqual(report.Overwritten, []string{"b"}) {
+		t.Errorf("Expected overwritten [b], got %v", report.Overwritten)
+	}
+	if report := MergeIntoReport(nil, map[string]int{"a": 1}); report.Added != nil || report.Overwritten != nil {
+		t.Errorf("Expected empty report for nil dst, got %+v", report)
+	}
+}
+
+func TestAssignMissing(t *testing.T) {
+	dst := map[string]int{"a": 1}
+	AssignMissing(dst, map[string]int{"a": 10, "b": 2}, map[string]int{"b": 20, "c": 3})
+	expected := map[string]int{"a": 1, "b": 2, "c": 3}
+	if !reflect.DeepEqual(dst, expected) {
+		t.Errorf("Expected %v, got %v", expected, dst)
+	}
+}
+
+func TestApplyDefaults(t *testing.T) {
+	opts := map[string]string{"timeout": "5s"}
+	defaults := map[string]string{"timeout": "30s", "retries": "3"}
+
+	got := ApplyDefaults(opts, defaults)
+	expected := map[string]string{"timeout": "5s", "retries": "3"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if len(opts) != 1 || len(defaults) != 2 {
+		t.Error("ApplyDefaults modified its inputs")
+	}
+	if got := ApplyDefaults[string, string](nil, nil); got == nil || len(got) != 0 {
+		t.Errorf("Expected empty non-nil map, got %v", got)
+	}
+}
+
+func TestApplyDefaultsFunc(t *testing.T) {
+	var asked []string
+	provider := func(k string) (int, bool) {
+		asked = append(asked, k)
+		if k == "skip" {
+			return 0, false
+		}
+		return len(k), true
+	}
+
+	got := ApplyDefaultsFunc(map[string]int{"a": 1}, []string{"a", "bb", "skip"}, provider)
+	expected := map[string]int{"a": 1, "bb": 2}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if !reflect.DeepEqual(asked, []string{"bb", "skip"}) {
+		t.Errorf("Expected provider to be called only for missing keys, got %v", asked)
+	}
+}
+
 func TestFilter(t *testing.T) {
 	m := map[string]int{"a": 1, "b": 2, "c": 3}
 	filtered := Filter(m, func(k string, v int) bool {
@@ -73,6 +313,93 @@ func TestMapValues(t *testing.T) {
 	}
 }
 
+func TestRemapKeys(t *testing.T) {
+	m := map[string]int{"user_name": 1, "mail": 2, "email": 3, "age": 4}
+	aliases := map[string]string{"user_name": "name", "mail": "email"}
+	remapped := RemapKeys(m, aliases)
+	expected := map[string]int{"name": 1, "email": 3, "age": 4}
+	if !reflect.DeepEqual(remapped, expected) {
+		t.Errorf("Expected %v, got %v", expected, remapped)
+	}
+	if _, ok := m["user_name"]; !ok {
+		t.Error("RemapKeys should not modify the input map")
+	}
+
+	swapped := RemapKeys(map[string]int{"a": 1, "b": 2}, map[string]string{"a": "b", "b": "a"})
+	expected = map[string]int{"a": 2, "b": 1}
+	if !reflect.DeepEqual(swapped, expected) {
+		t.Errorf("Expected %v, got %v", expected, swapped)
+	}
+
+	// Renamed keys colliding on one name resolve by sorted source key: the smallest wins.
+	for range 50 {
+		collided := RemapKeys(map[string]int{"b": 2, "a": 1, "c": 3}, map[string]string{"a": "x", "b": "x", "c": "x"})
+		if expected := map[string]int{"x": 1}; !reflect.DeepEqual(collided, expected) {
+			t.Fatalf("Expected %v, got %v", expected, collided)
+		}
+	}
+}
+
+func TestRenameKey(t *testing.T) {
+	m := map[string]int{"old": 1}
+	if !RenameKey(m, "old", "new") {
+		t.Error("Expected RenameKey to report existing key")
+	}
+	expected := map[string]int{"new": 1}
+	if !reflect.DeepEqual(m, expected) {
+		t.Errorf("Expected %v, got %v", expected, m)
+	}
+	if RenameKey(m, "missing", "other") {
+		t.Error("Expected RenameKey to report missing key")
+	}
+}
+
+func TestCanonicalizeKeys(t *testing.T) {
+	headers := map[string][]string{
+		"Content-Type": {"text/plain"},
+		"X-Trace":      {"a"},
+		"x-trace":      {"b"},
+		"X-TRACE":      {"c"},
+	}
+	joined := CanonicalizeKeys(headers, strings.ToLower, func(_ string, old, new []string) []string {
+		return append(old, new...)
+	})
+	expected := map[string][]string{
+		"content-type": {"text/plain"},
+		"x-trace":      {"c", "a", "b"}, // sorted source keys: X-TRACE, X-Trace, x-trace
+	}
+	if !reflect.DeepEqual(joined, expected) {
+		t.Errorf("Expected %v, got %v", expected, joined)
+	}
+
+	first := CanonicalizeKeys(headers, strings.ToLower, nil)
+	if !reflect.DeepEqual(first["x-trace"], []string{"c"}) {
+		t.Errorf("Expected value of smallest source key, got %v", first["x-trace"])
+	}
+	if CanonicalizeKeys[int](nil, strings.ToLower, nil) != nil {
+		t.Error("Expected nil result for nil input")
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	m := map[string]map[string]int{
+		"api": {"us": 1, "eu": 2},
+		"web": {"us": 3},
+		"db":  {},
+	}
+	transposed := Transpose(m)
+	expected := map[string]map[string]int{
+		"us": {"api": 1, "web": 3},
+		"eu": {"api": 2},
+	}
+	if !reflect.DeepEqual(transposed, expected) {
+		t.Errorf("Expected %v, got %v", expected, transposed)
+	}
+	if Transpose[string, string, int](nil) != nil {
+		t.Error("Expected nil result for nil input")
+	}
+}
+
 func TestToSet(t *testing.T) {
 	input := []string{"a", "b", "a"}
 	set := ToSet(input)
@@ -116,3 +443,70 @@ func TestSortedValues(t *testing.T) {
 		t.Errorf("Expected %v, got %v", expected, vals)
 	}
 }
+
+func TestKeysSortedByValue(t *testing.T) {
+	scores := map[string]float64{"bob": 7.5, "alice": 9.1, "carol": 3.2}
+
+	byScore := KeysSortedByValue(scores, func(a, b float64) bool { return a > b })
+	expected := []string{"alice", "bob", "carol"}
+	if !reflect.DeepEqual(byScore, expected) {
+		t.Errorf("Expected %v, got %v", expected, byScore)
+	}
+
+	if asc := KeysSortedByValueAsc(scores); !reflect.DeepEqual(asc, []string{"carol", "bob", "alice"}) {
+		t.Errorf("Expected ascending order, got %v", asc)
+	}
+	if desc := KeysSortedByValueDesc(scores); !reflect.DeepEqual(desc, expected) {
+		t.Errorf("Expected %v, got %v", expected, desc)
+	}
+	if keys := KeysSortedByValueAsc(map[string]int{}); len(keys) != 0 {
+		t.Errorf("Expected empty result, got %v", keys)
+	}
+
+	keys := KeysSortedByValue(scores, nil)
+	sort.Strings(keys)
+	if expected := []string{"alice", "bob", "carol"}; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Expected %v for nil less, got %v", expected, keys)
+	}
+}
+
+func TestInvert(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	expected := map[int]string{1: "a", 2: "b"}
+	if got := Invert(m); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if got := Invert[string, int](nil); got != nil {
+		t.Errorf("Expected nil, got %v", got)
+	}
+}
+
+func TestInvertToSets(t *testing.T) {
+	roles := map[string]string{"alice": "admin", "bob": "viewer", "carol": "admin"}
+	got := InvertToSets(roles)
+	expected := map[string]map[string]struct{}{
+		"admin":  {"alice": {}, "carol": {}},
+		"viewer": {"bob": {}},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if got := InvertToSets[string, string](nil); got != nil {
+		t.Errorf("Expected nil, got %v", got)
+	}
+}
+
+func TestGroupKeysByValue(t *testing.T) {
+	states := map[string]string{"web-1": "up", "web-2": "down", "db-1": "up"}
+	got := GroupKeysByValue(states)
+	for _, hosts := range got {
+		sort.Strings(hosts)
+	}
+	expected := map[string][]string{"up": {"db-1", "web-1"}, "down": {"web-2"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if got := GroupKeysByValue[string, string](nil); got != nil {
+		t.Errorf("Expected nil, got %v", got)
+	}
+}
@@ -0,0 +1,117 @@
+package record
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// KeysSeq returns an iterator over the keys of the map.
+// The order of keys is not guaranteed.
+func KeysSeq[K comparable, V any](m map[K]V) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over the values of the map.
+// The order of values is not guaranteed.
+func ValuesSeq[K comparable, V any](m map[K]V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// EntriesSeq returns an iterator over the key-value pairs of the map.
+// The order of entries is not guaranteed.
+func EntriesSeq[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// SortedKeysSeq returns an iterator over the keys of the map, in ascending order.
+// The keys are collected and sorted when iteration starts.
+func SortedKeysSeq[K cmp.Ordered, V any](m map[K]V) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, k := range SortedKeys(m) {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SortedValuesSeq returns an iterator over the values of the map, in ascending order.
+// The values are collected and sorted when iteration starts.
+func SortedValuesSeq[K comparable, V cmp.Ordered](m map[K]V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range SortedValues(m) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SortedEntriesSeq returns an iterator over the key-value pairs of the map, in ascending
+// key order. The keys are collected and sorted when iteration starts; values are looked
+// up as iteration proceeds.
+func SortedEntriesSeq[K cmp.Ordered, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		keys := Keys(m)
+		slices.Sort(keys)
+		for _, k := range keys {
+			if !yield(k, m[k]) {
+				return
+			}
+		}
+	}
+}
+
+// FilterSeq returns an iterator over the entries of the map that satisfy the predicate,
+// without building an intermediate map. The order of entries is not guaranteed.
+func FilterSeq[K comparable, V any](m map[K]V, predicate func(k K, v V) bool) iter.Seq2[K, V] {
+	return FilterSeq2(EntriesSeq(m), predicate)
+}
+
+// MapValuesSeq returns an iterator over the entries of the map with each value
+// transformed, without building an intermediate map. The order of entries is not guaranteed.
+func MapValuesSeq[K comparable, V, W any](m map[K]V, transform func(v V) W) iter.Seq2[K, W] {
+	return MapValuesSeq2(EntriesSeq(m), transform)
+}
+
+// FilterSeq2 returns an iterator over the pairs of seq that satisfy the predicate.
+// Use it to chain stages after FilterSeq or MapValuesSeq; maps.Collect materializes the result.
+func FilterSeq2[K, V any](seq iter.Seq2[K, V], predicate func(k K, v V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if predicate(k, v) && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// MapValuesSeq2 returns an iterator over the pairs of seq with each value transformed.
+func MapValuesSeq2[K, V, W any](seq iter.Seq2[K, V], transform func(v V) W) iter.Seq2[K, W] {
+	return func(yield func(K, W) bool) {
+		for k, v := range seq {
+			if !yield(k, transform(v)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package record
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestKeysValuesEntriesSeq(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	var keys []string
+	for k := range KeysSeq(m) {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if !reflect.DeepEqual(keys, []string{"a", "b", "c"}) {
+		t.Errorf("Expected [a b c], got %v", keys)
+	}
+
+	var vals []int
+	for v := range ValuesSeq(m) {
+		vals = append(vals, v)
+	}
+	sort.Ints(vals)
+	if !reflect.DeepEqual(vals, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", vals)
+	}
+
+	got := make(map[string]int)
+	for k, v := range EntriesSeq(m) {
+		got[k] = v
+	}
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("Expected %v, got %v", m, got)
+	}
+
+	count := 0
+	for range KeysSeq(m) {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("Expected early break to stop iteration, got %d", count)
+	}
+}
+
+func TestSortedSeq(t *testing.T) {
+	m := map[string]int{"b": 1, "c": 3, "a": 2}
+
+	var keys []string
+	for k := range SortedKeysSeq(m) {
+		keys = append(keys, k)
+	}
+	if !reflect.DeepEqual(keys, []string{"a", "b", "c"}) {
+		t.Errorf("Expected [a b c], got %v", keys)
+	}
+
+	var vals []int
+	for v := range SortedValuesSeq(m) {
+		vals = append(vals, v)
+	}
+	if !reflect.DeepEqual(vals, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", vals)
+	}
+
+	var pairs []string
+	for k, v := range SortedEntriesSeq(m) {
+		pairs = append(pairs, k+"="+string(rune('0'+v)))
+		if len(pairs) == 2 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(pairs, []string{"a=2", "b=1"}) {
+		t.Errorf("Expected [a=2 b=1], got %v", pairs)
+	}
+}
+
+func TestFilterSeq(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+	got := maps.Collect(FilterSeq(m, func(_ string, v int) bool { return v%2 == 0 }))
+	expected := map[string]int{"b": 2, "d": 4}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	count := 0
+	for range FilterSeq(m, func(string, int) bool { return true }) {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("Expected iteration to stop early, got %d entries", count)
+	}
+}
+
+func TestMapValuesSeq(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	// Chain stages without intermediate maps.
+	doubled := MapValuesSeq(m, func(v int) int { return v * 2 })
+	big := FilterSeq2(doubled, func(_ string, v int) bool { return v > 2 })
+	labels := MapValuesSeq2(big, func(v int) string { return fmt.Sprint(v) })
+
+	got := maps.Collect(labels)
+	expected := map[string]string{"b": "4", "c": "6"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,183 @@
+package record
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// tagName is the struct tag key used to customize field names.
+const tagName = "record"
+
+// structField describes an exported struct field and the map key it is bound to.
+type structField struct {
+	index     int
+	name      string
+	omitEmpty bool
+}
+
+// structFields returns the exported fields of a struct type, honoring `record` tags.
+// A tag of "-" skips the field; an ",omitempty" option is recorded for encoding.
+func structFields(t reflect.Type) []structField {
+	fields := make([]structField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := f.Tag.Get(tagName)
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, structField{
+			index:     i,
+			name:      name,
+			omitEmpty: opts == "omitempty",
+		})
+	}
+	return fields
+}
+
+// Encode converts a struct (or pointer to struct) into a map keyed by field name.
+// Only exported fields are included. The `record:"name"` tag overrides the key,
+// `record:"-"` skips the field and `record:"name,omitempty"` skips zero values.
+// Returns nil if v is not a struct or is a nil pointer.
+func Encode(v any) map[string]any {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fields := structFields(rv.Type())
+	result := make(map[string]any, len(fields))
+	for _, f := range fields {
+		fv := rv.Field(f.index)
+		if f.omitEmpty && fv.IsZero() {
+			continue
+		}
+		result[f.name] = fv.Interface()
+	}
+	return result
+}
+
+// Decode copies the entries of m into the struct pointed to by out, matching keys
+// against field names or their `record:"name"` tags.
+// Keys without a matching field are ignored. Numeric values are converted between
+// numeric kinds when no precision or sign is lost (e.g. float64 from JSON into an int
+// field), nested map[string]any values are decoded into struct fields recursively, and
+// slices and maps are converted element by element (e.g. []any into a []string field).
+// It returns an error if out is not a non-nil pointer to a struct or a value cannot
+// be assigned to its field.
+func Decode(m map[string]any, out any) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("record: decode target must be a non-nil pointer to a struct, got %T", out)
+	}
+	return decodeStruct(m, rv.Elem())
+}
+
+// decodeStruct assigns the entries of m to the fields of the addressable struct value rv.
+func decodeStruct(m map[string]any, rv reflect.Value) error {
+	for _, f := range structFields(rv.Type()) {
+		raw, ok := m[f.name]
+		if !ok {
+			continue
+		}
+		if err := assignValue(rv.Field(f.index), raw); err != nil {
+			return fmt.Errorf("record: field %q: %w", f.name, err)
+		}
+	}
+	return nil
+}
+
+// assignValue stores raw into dst, converting it when the conversion is lossless.
+func assignValue(dst reflect.Value, raw any) error {
+	if raw == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+	src := reflect.ValueOf(raw)
+	switch {
+	case src.Type().AssignableTo(dst.Type()):
+		dst.Set(src)
+		return nil
+	case isNumeric(src.Kind()) && isNumeric(dst.Kind()):
+		converted := src.Convert(dst.Type())
+		if !converted.Convert(src.Type()).Equal(src) || isNegative(converted) != isNegative(src) {
+			return fmt.Errorf("cannot convert %v to %s without losing precision", raw, dst.Type())
+		}
+		dst.Set(converted)
+		return nil
+	case dst.Kind() == reflect.Slice && (src.Kind() == reflect.Slice || src.Kind() == reflect.Array):
+		converted := reflect.MakeSlice(dst.Type(), src.Len(), src.Len())
+		for i := range src.Len() {
+			if err := assignValue(converted.Index(i), src.Index(i).Interface()); err != nil {
+				return fmt.Errorf("index %d: %w", i, err)
+			}
+		}
+		dst.Set(converted)
+		return nil
+	case dst.Kind() == reflect.Map && src.Kind() == reflect.Map:
+		converted := reflect.MakeMapWithSize(dst.Type(), src.Len())
+		key := reflect.New(dst.Type().Key()).Elem()
+		elem := reflect.New(dst.Type().Elem()).Elem()
+		iter := src.MapRange()
+		for iter.Next() {
+			if err := assignValue(key, iter.Key().Interface()); err != nil {
+				return fmt.Errorf("key %v: %w", iter.Key(), err)
+			}
+			if err := assignValue(elem, iter.Value().Interface()); err != nil {
+				return fmt.Errorf("key %v: %w", iter.Key(), err)
+			}
+			converted.SetMapIndex(key, elem)
+		}
+		dst.Set(converted)
+		return nil
+	case dst.Kind() == reflect.Struct:
+		if nested, ok := raw.(map[string]any); ok {
+			return decodeStruct(nested, dst)
+		}
+	case dst.Kind() == reflect.Pointer && dst.Type().Elem().Kind() == reflect.Struct:
+		if nested, ok := raw.(map[string]any); ok {
+			ptr := reflect.New(dst.Type().Elem())
+			if err := decodeStruct(nested, ptr.Elem()); err != nil {
+				return err
+			}
+			dst.Set(ptr)
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot assign %T to %s", raw, dst.Type())
+}
+
+// isNegative reports whether the numeric value v is below zero.
+func isNegative(v reflect.Value) bool {
+	switch {
+	case v.CanInt():
+		return v.Int() < 0
+	case v.CanFloat():
+		return v.Float() < 0
+	}
+	return false
+}
+
+// isNumeric reports whether k is an integer or floating-point kind.
+func isNumeric(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+package record
+
+import (
+	"reflect"
+	"testing"
+)
+
+type address struct {
+	City string `record:"city"`
+}
+
+type profile struct {
+	Name     string   `record:"name"`
+	Age      int      `record:"age"`
+	Email    string   `record:"email,omitempty"`
+	Password string   `record:"-"`
+	Tags     []string `record:"tags"`
+	Home     address  `record:"home"`
+	Work     *address `record:"work"`
+	Score    float64
+	internal int
+}
+
+func TestEncode(t *testing.T) {
+	p := profile{
+		Name:     "alice",
+		Age:      30,
+		Password: "secret",
+		Tags:     []string{"admin"},
+		Home:     address{City: "Hanoi"},
+		Score:    1.5,
+		internal: 1,
+	}
+	got := Encode(&p)
+	expected := map[string]any{
+		"name":  "alice",
+		"age":   30,
+		"tags":  []string{"admin"},
+		"home":  address{City: "Hanoi"},
+		"work":  (*address)(nil),
+		"Score": 1.5,
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	if Encode(42) != nil {
+		t.Error("Expected nil for non-struct input")
+	}
+	if Encode((*profile)(nil)) != nil {
+		t.Error("Expected nil for nil pointer input")
+	}
+}
+
+func TestDecode(t *testing.T) {
+	m := map[string]any{
+		"name":     "bob",
+		"age":      float64(42), // as produced by encoding/json
+		"email":    "bob@example.com",
+		"Password": "ignored",
+		"tags":     []string{"a", "b"},
+		"home":     map[string]any{"city": "Hue"},
+		"work":     map[string]any{"city": "Da Nang"},
+		"unknown":  true,
+	}
+	var p profile
+	if err := Decode(m, &p); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := profile{
+		Name:  "bob",
+		Age:   42,
+		Email: "bob@example.com",
+		Tags:  []string{"a", "b"},
+		Home:  address{City: "Hue"},
+		Work:  &address{City: "Da Nang"},
+	}
+	if !reflect.DeepEqual(p, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, p)
+	}
+}
+
+func TestDecode_Errors(t *testing.T) {
+	var p profile
+	if err := Decode(map[string]any{}, p); err == nil {
+		t.Error("Expected error for non-pointer target")
+	}
+	if err := Decode(map[string]any{"age": "old"}, &p); err == nil {
+		t.Error("Expected error for mismatched type")
+	}
+	if err := Decode(map[string]any{"age": 1.5}, &p); err == nil {
+		t.Error("Expected error for lossy numeric conversion")
+	}
+
+	var counts struct {
+		N uint `record:"n"`
+		S int8 `record:"s"`
+	}
+	if err := Decode(map[string]any{"n": -1}, &counts); err == nil {
+		t.Errorf("Expected error for negative value into unsigned field, got %d", counts.N)
+	}
+	if err := Decode(map[string]any{"n": float64(-1)}, &counts); err == nil {
+		t.Errorf("Expected error for negative float into unsigned field, got %d", counts.N)
+	}
+	if err := Decode(map[string]any{"s": uint8(200)}, &counts); err == nil {
+		t.Errorf("Expected error for value that wraps negative, got %d", counts.S)
+	}
+	if err := Decode(map[string]any{"tags": []any{"a", 1}}, &p); err == nil {
+		t.Error("Expected error for mismatched slice element")
+	}
+}
+
+func TestDecode_Collections(t *testing.T) {
+	var out struct {
+		Tags   []string          `record:"tags"`
+		Labels map[string]string `record:"labels"`
+		Counts map[string]int    `record:"counts"`
+		Homes  []address         `record:"homes"`
+	}
+	// As produced by encoding/json into map[string]any.
+	m := map[string]any{
+		"tags":   []any{"a", "b"},
+		"labels": map[string]any{"env": "prod"},
+		"counts": map[string]any{"x": float64(3)},
+		"homes":  []any{map[string]any{"city": "Hue"}},
+	}
+	if err := Decode(m, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(out.Tags, []string{"a", "b"}) {
+		t.Errorf("Expected [a b], got %v", out.Tags)
+	}
+	if !reflect.DeepEqual(out.Labels, map[string]string{"env": "prod"}) {
+		t.Errorf("Expected map[env:prod], got %v", out.Labels)
+	}
+	if !reflect.DeepEqual(out.Counts, map[string]int{"x": 3}) {
+		t.Errorf("Expected map[x:3], got %v", out.Counts)
+	}
+	if !reflect.DeepEqual(out.Homes, []address{{City: "Hue"}}) {
+		t.Errorf("Expected [{Hue}], got %v", out.Homes)
+	}
+}
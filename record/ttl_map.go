@@ -0,0 +1,234 @@
+package record
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// TTLMap is a map whose entries expire after a time-to-live.
+// Expired entries are evicted lazily on access, and periodically if WithCleanup is used.
+// A TTLMap is safe for concurrent use. Configure it with OnEvict and WithCleanup
+// before sharing it between goroutines.
+type TTLMap[K comparable, V any] struct {
+	mu       sync.Mutex
+	entries  map[K]ttlEntry[V]
+	loads    map[K]*ttlLoad[V]
+	ttl      time.Duration
+	onEvict  func(key K, value V)
+	stop     chan struct{}
+	stopOnce sync.Once
+	now      func() time.Time
+}
+
+// errLoaderPanicked is returned to callers waiting on a GetOrLoad loader that panicked.
+var errLoaderPanicked = errors.New("record: GetOrLoad loader panicked")
+
+// ttlEntry is a value with its expiry time.
+type ttlEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// ttlLoad is an in-flight GetOrLoad call shared by concurrent callers.
+type ttlLoad[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+	// superseded is set when the key is Set or Deleted while the load runs,
+	// so that the loaded value does not overwrite the newer state.
+	superseded bool
+}
+
+// NewTTLMap creates an empty TTLMap whose entries expire ttl after they are set.
+// A ttl <= 0 means entries never expire unless set with SetWithTTL.
+func NewTTLMap[K comparable, V any](ttl time.Duration) *TTLMap[K, V] {
+	return &TTLMap[K, V]{
+		entries: make(map[K]ttlEntry[V]),
+		loads:   make(map[K]*ttlLoad[V]),
+		ttl:     ttl,
+		stop:    make(chan struct{}),
+		now:     time.Now,
+	}
+}
+
+// OnEvict registers fn to be called for every entry that expires. It is not called
+// for entries removed with Delete or replaced with Set. fn is called without
+// holding the map's lock, so it may use the map.
+func (t *TTLMap[K, V]) OnEvict(fn func(key K, value V)) *TTLMap[K, V] {
+	t.onEvict = fn
+	return t
+}
+
+// WithCleanup starts a background goroutine that evicts expired entries every interval.
+// Call Close to stop it. Without it, expired entries are only evicted when accessed
+// or when Cleanup is called.
+func (t *TTLMap[K, V]) WithCleanup(interval time.Duration) *TTLMap[K, V] {
+	if interval <= 0 {
+		return t
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.Cleanup()
+			case <-t.stop:
+				return
+			}
+		}
+	}()
+	return t
+}
+
+// Close stops the background cleanup goroutine, if any. The map remains usable.
+func (t *TTLMap[K, V]) Close() {
+	t.stopOnce.Do(func() { close(t.stop) })
+}
+
+// Set stores value for key with the map's default TTL.
+func (t *TTLMap[K, V]) Set(key K, value V) {
+	t.SetWithTTL(key, value, t.ttl)
+}
+
+// SetWithTTL stores value for key, expiring after ttl. A ttl <= 0 means the entry never expires.
+func (t *TTLMap[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.supersede(key)
+	t.entries[key] = t.newEntry(value, ttl)
+}
+
+// Get returns the value stored for key, and false if it is missing or expired.
+func (t *TTLMap[K, V]) Get(key K) (V, bool) {
+	t.mu.Lock()
+	v, ok, evicted := t.lookup(key)
+	t.mu.Unlock()
+	if evicted {
+		t.evicted(key, v)
+		var zero V
+		return zero, false
+	}
+	return v, ok
+}
+
+// GetOrLoad returns the value stored for key, calling loader to produce and store it
+// if it is missing or expired. Concurrent calls for the same key share a single loader
+// call. If loader fails, nothing is stored and every waiting caller gets the error.
+// If key is Set or Deleted while loader runs, the loaded value is returned to the
+// waiting callers but not stored, so the newer state wins.
+// If loader panics, the panic propagates to the caller that ran it and the others get an error.
+func (t *TTLMap[K, V]) GetOrLoad(key K, loader func(key K) (V, error)) (V, error) {
+	t.mu.Lock()
+	v, ok, evicted := t.lookup(key)
+	if ok {
+		t.mu.Unlock()
+		return v, nil
+	}
+	if l, ok := t.loads[key]; ok {
+		t.mu.Unlock()
+		<-l.done
+		return l.value, l.err
+	}
+	l := &ttlLoad[V]{done: make(chan struct{})}
+	t.loads[key] = l
+	t.mu.Unlock()
+	if evicted {
+		t.evicted(key, v)
+	}
+
+	completed := false
+	defer close(l.done)
+	defer func() {
+		if !completed {
+			l.err = errLoaderPanicked
+		}
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		delete(t.loads, key)
+		if l.err == nil && !l.superseded {
+			t.entries[key] = t.newEntry(l.value, t.ttl)
+		}
+	}()
+	l.value, l.err = loader(key)
+	completed = true
+	return l.value, l.err
+}
+
+// Delete removes key from the map.
+func (t *TTLMap[K, V]) Delete(key K) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.supersede(key)
+	delete(t.entries, key)
+}
+
+// Len returns the number of entries in the map, including expired entries that
+// have not been evicted yet.
+func (t *TTLMap[K, V]) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.entries)
+}
+
+// Cleanup evicts all expired entries and returns how many were evicted.
+func (t *TTLMap[K, V]) Cleanup() int {
+	t.mu.Lock()
+	now := t.now()
+	var expired []Entry[K, V]
+	for k, e := range t.entries {
+		if e.expired(now) {
+			expired = append(expired, Entry[K, V]{Key: k, Value: e.value})
+			delete(t.entries, k)
+		}
+	}
+	t.mu.Unlock()
+	for _, e := range expired {
+		t.evicted(e.Key, e.Value)
+	}
+	return len(expired)
+}
+
+// supersede marks an in-flight load of key, if any, as outdated. The caller must hold t.mu.
+func (t *TTLMap[K, V]) supersede(key K) {
+	if l, ok := t.loads[key]; ok {
+		l.superseded = true
+	}
+}
+
+// newEntry builds an entry for value expiring after ttl. The caller must hold t.mu.
+func (t *TTLMap[K, V]) newEntry(value V, ttl time.Duration) ttlEntry[V] {
+	e := ttlEntry[V]{value: value}
+	if ttl > 0 {
+		e.expiresAt = t.now().Add(ttl)
+	}
+	return e
+}
+
+// lookup returns the live value for key. If the entry has expired it is removed and
+// returned with evicted set, so the caller can report it after releasing t.mu.
+// The caller must hold t.mu.
+func (t *TTLMap[K, V]) lookup(key K) (value V, ok, evicted bool) {
+	e, found := t.entries[key]
+	if !found {
+		return value, false, false
+	}
+	if e.expired(t.now()) {
+		delete(t.entries, key)
+		return e.value, false, true
+	}
+	return e.value, true, false
+}
+
+// evicted reports an expired entry to the eviction callback, if any.
+func (t *TTLMap[K, V]) evicted(key K, value V) {
+	if t.onEvict != nil {
+		t.onEvict(key, value)
+	}
+}
+
+// expired reports whether the entry has expired at now.
+func (e ttlEntry[V]) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
@@ -0,0 +1,202 @@
+package record
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeClock is a manually advanced clock for TTLMap tests.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestTTLMap(ttl time.Duration) (*TTLMap[string, int], *fakeClock) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	m := NewTTLMap[string, int](ttl)
+	m.now = clock.Now
+	return m, clock
+}
+
+func TestTTLMap_Expiry(t *testing.T) {
+	m, clock := newTestTTLMap(time.Minute)
+	var evicted []string
+	m.OnEvict(func(k string, v int) { evicted = append(evicted, k) })
+
+	m.Set("a", 1)
+	m.SetWithTTL("b", 2, 2*time.Minute)
+	m.SetWithTTL("forever", 3, 0)
+
+	if v, ok := m.Get("a"); !ok || v != 1 {
+		t.Errorf("Expected a=1, got %v, %v", v, ok)
+	}
+
+	clock.Advance(time.Minute)
+	if _, ok := m.Get("a"); ok {
+		t.Error("Expected a to be expired")
+	}
+	if v, ok := m.Get("b"); !ok || v != 2 {
+		t.Errorf("Expected b=2, got %v, %v", v, ok)
+	}
+	if len(evicted) != 1 || evicted[0] != "a" {
+		t.Errorf("Expected a to be evicted, got %v", evicted)
+	}
+
+	clock.Advance(time.Hour)
+	if n := m.Cleanup(); n != 1 {
+		t.Errorf("Expected 1 entry cleaned up, got %d", n)
+	}
+	if m.Len() != 1 {
+		t.Errorf("Expected only the non-expiring entry, got %d entries", m.Len())
+	}
+	if _, ok := m.Get("forever"); !ok {
+		t.Error("Expected entry without TTL to survive")
+	}
+
+	m.Delete("forever")
+	if m.Len() != 0 || len(evicted) != 2 {
+		t.Errorf("Expected Delete not to trigger OnEvict, got %v", evicted)
+	}
+}
+
+func TestTTLMap_GetOrLoad(t *testing.T) {
+	m, clock := newTestTTLMap(time.Minute)
+	var calls atomic.Int32
+	release := make(chan struct{})
+	loader := func(k string) (int, error) {
+		calls.Add(1)
+		<-release
+		return len(k), nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]int, 8)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := m.GetOrLoad("abc", loader)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			results[i] = v
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Errorf("Expected a single loader call, got %d", calls.Load())
+	}
+	for _, v := range results {
+		if v != 3 {
+			t.Errorf("Expected 3, got %v", results)
+			break
+		}
+	}
+
+	// Cached until expiry, then reloaded.
+	if _, _ = m.GetOrLoad("abc", loader); calls.Load() != 1 {
+		t.Error("Expected cached value to be used")
+	}
+	clock.Advance(time.Minute)
+	if _, _ = m.GetOrLoad("abc", loader); calls.Load() != 2 {
+		t.Error("Expected expired value to be reloaded")
+	}
+}
+
+func TestTTLMap_GetOrLoadSuperseded(t *testing.T) {
+	tests := []struct {
+		name   string
+		during func(m *TTLMap[string, int])
+		want   int
+		wantOK bool
+	}{
+		{name: "set during load", during: func(m *TTLMap[string, int]) { m.Set("k", 7) }, want: 7, wantOK: true},
+		{name: "delete during load", during: func(m *TTLMap[string, int]) { m.Delete("k") }, want: 0, wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := newTestTTLMap(time.Minute)
+			started := make(chan struct{})
+			release := make(chan struct{})
+			done := make(chan int)
+			go func() {
+				v, _ := m.GetOrLoad("k", func(string) (int, error) {
+					close(started)
+					<-release
+					return 1, nil
+				})
+				done <- v
+			}()
+			<-started
+			tt.during(m)
+			close(release)
+			if v := <-done; v != 1 {
+				t.Errorf("Expected the loader's caller to get 1, got %d", v)
+			}
+			if v, ok := m.Get("k"); v != tt.want || ok != tt.wantOK {
+				t.Errorf("Expected %v, %v, got %v, %v", tt.want, tt.wantOK, v, ok)
+			}
+		})
+	}
+}
+
+func TestTTLMap_GetOrLoadError(t *testing.T) {
+	m, _ := newTestTTLMap(time.Minute)
+	errLoad := errors.New("unavailable")
+
+	if _, err := m.GetOrLoad("a", func(string) (int, error) { return 0, errLoad }); !errors.Is(err, errLoad) {
+		t.Errorf("Expected errLoad, got %v", err)
+	}
+	if _, ok := m.Get("a"); ok {
+		t.Error("Expected failed load not to be stored")
+	}
+
+	func() {
+		defer func() { _ = recover() }()
+		_, _ = m.GetOrLoad("p", func(string) (int, error) { panic("boom") })
+	}()
+	if _, ok := m.Get("p"); ok {
+		t.Error("Expected panicking load not to be stored")
+	}
+	if v, err := m.GetOrLoad("p", func(string) (int, error) { return 7, nil }); err != nil || v != 7 {
+		t.Errorf("Expected a later load to succeed, got %v, %v", v, err)
+	}
+}
+
+func TestTTLMap_WithCleanup(t *testing.T) {
+	m, clock := newTestTTLMap(time.Minute)
+	evicted := make(chan string, 1)
+	m.OnEvict(func(k string, _ int) { evicted <- k }).WithCleanup(time.Millisecond)
+	defer m.Close()
+
+	m.Set("a", 1)
+	clock.Advance(time.Minute)
+
+	select {
+	case k := <-evicted:
+		if k != "a" {
+			t.Errorf("Expected a to be evicted, got %q", k)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected background cleanup to evict the entry")
+	}
+	m.Close() // idempotent
+}
@@ -0,0 +1,150 @@
+// Package sched runs jobs periodically, with jitter, overlap prevention and panic capture.
+package sched
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/cirius-go/devutil/internal/panicerr"
+	"github.com/cirius-go/devutil/slice"
+)
+
+// Job is a unit of periodic work.
+type Job func(ctx context.Context) error
+
+// RunInfo describes a completed run of a job.
+type RunInfo struct {
+	Run      int           // 1-based run number
+	Started  time.Time     // when the run started
+	Duration time.Duration // how long the run took
+	Skipped  int           // scheduled runs dropped since the previous run because it overran
+	Err      error         // error returned by the job, or a *PanicError
+}
+
+// PanicError represents a panic recovered from a job. Its Index is the run number.
+// It is the same type as the PanicError of the slice, record, async and flight packages.
+type PanicError = panicerr.Error
+
+// Schedule describes when and how a job runs. Configure it with the chainable
+// methods, then start it with Run.
+type Schedule struct {
+	interval  time.Duration
+	jitter    time.Duration
+	immediate bool
+	before    []func(ctx context.Context, run int)
+	after     []func(ctx context.Context, info RunInfo)
+	wrappers  []func(Job) Job
+}
+
+// Every creates a Schedule that runs a job once per interval.
+// Runs never overlap: if a run takes longer than the interval, the missed slots are
+// skipped and the next run starts at the following slot.
+func Every(interval time.Duration) *Schedule {
+	return &Schedule{interval: interval}
+}
+
+// Jitter delays each run by a random duration in [0, maxDelay), to spread load when many
+// instances share the same schedule.
+func (s *Schedule) Jitter(maxDelay time.Duration) *Schedule {
+	s.jitter = maxDelay
+	return s
+}
+
+// Immediate makes the first run start as soon as Run is called, instead of after
+// the first interval.
+func (s *Schedule) Immediate() *Schedule {
+	s.immediate = true
+	return s
+}
+
+// Before registers a hook called before each run.
+func (s *Schedule) Before(fn func(ctx context.Context, run int)) *Schedule {
+	s.before = append(s.before, fn)
+	return s
+}
+
+// After registers a hook called after each run, with its outcome.
+func (s *Schedule) After(fn func(ctx context.Context, info RunInfo)) *Schedule {
+	s.after = append(s.after, fn)
+	return s
+}
+
+// Wrap registers middleware applied around the job, e.g. to add timeouts or tracing.
+// Wrappers registered first are outermost.
+func (s *Schedule) Wrap(wrapper func(Job) Job) *Schedule {
+	s.wrappers = append(s.wrappers, wrapper)
+	return s
+}
+
+// Run executes job on the schedule until ctx is done, then returns ctx.Err().
+// Job errors and panics do not stop the schedule; they are reported to After hooks,
+// with panics recovered as a *PanicError.
+func (s *Schedule) Run(ctx context.Context, job Job) error {
+	if s.interval <= 0 {
+		return fmt.Errorf("sched: interval must be positive, got %s", s.interval)
+	}
+	for i := len(s.wrappers) - 1; i >= 0; i-- {
+		job = s.wrappers[i](job)
+	}
+
+	next := time.Now()
+	if !s.immediate {
+		next = next.Add(s.interval)
+	}
+	skipped := 0
+	for run := 1; ; run++ {
+		wait := time.Until(next)
+		if s.jitter > 0 {
+			wait += rand.N(s.jitter)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		for _, fn := range s.before {
+			fn(ctx, run)
+		}
+		started := time.Now()
+		err := safeRun(ctx, run, job)
+		info := RunInfo{Run: run, Started: started, Duration: time.Since(started), Skipped: skipped, Err: err}
+		for _, fn := range s.after {
+			fn(ctx, info)
+		}
+
+		next = next.Add(s.interval)
+		skipped = 0
+		for now := time.Now(); next.Before(now); next = next.Add(s.interval) {
+			skipped++
+		}
+	}
+}
+
+// safeRun calls job, converting a panic into a *PanicError.
+func safeRun(ctx context.Context, run int, job Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicerr.New("run", run, r)
+		}
+	}()
+	return job(ctx)
+}
+
+// ChunkJob builds a Job that loads items and processes them with slice.ForEachChunk,
+// so periodic batch syncs need no glue code.
+func ChunkJob[T any](load func(ctx context.Context) ([]T, error), chunkSize, concurrency int, handler func(ctx context.Context, chunk []T) error) Job {
+	return func(ctx context.Context) error {
+		items, err := load(ctx)
+		if err != nil {
+			return err
+		}
+		return slice.ForEachChunk(items, chunkSize, concurrency, func(chunk []T) error {
+			return handler(ctx, chunk)
+		})
+	}
+}
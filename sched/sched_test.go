@@ -0,0 +1,164 @@
+package sched
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSchedule_Run(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 55*time.Millisecond)
+	defer cancel()
+
+	var runs int32
+	err := Every(10 * time.Millisecond).Run(ctx, func(ctx context.Context) error {
+		atomic.AddInt32(&runs, 1)
+		return nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline error, got %v", err)
+	}
+	if n := atomic.LoadInt32(&runs); n < 3 || n > 6 {
+		t.Errorf("Expected about 5 runs, got %d", n)
+	}
+}
+
+func TestSchedule_NoOverlap(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 80*time.Millisecond)
+	defer cancel()
+
+	var (
+		active int32
+		mu     sync.Mutex
+		infos  []RunInfo
+	)
+	_ = Every(5*time.Millisecond).Immediate().
+		After(func(_ context.Context, info RunInfo) {
+			mu.Lock()
+			infos = append(infos, info)
+			mu.Unlock()
+		}).
+		Run(ctx, func(ctx context.Context) error {
+			if atomic.AddInt32(&active, 1) > 1 {
+				t.Error("Runs overlapped")
+			}
+			time.Sleep(18 * time.Millisecond)
+			atomic.AddInt32(&active, -1)
+			return nil
+		})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(infos) < 2 {
+		t.Fatalf("Expected at least 2 runs, got %d", len(infos))
+	}
+	if infos[0].Skipped != 0 || infos[1].Skipped == 0 {
+		t.Errorf("Expected overrun slots to be skipped, got %d then %d", infos[0].Skipped, infos[1].Skipped)
+	}
+}
+
+func TestSchedule_ErrorsAndPanics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errJob := errors.New("job failed")
+	var (
+		errs []error
+		runs []int
+	)
+	_ = Every(time.Millisecond).Immediate().
+		Before(func(_ context.Context, run int) { runs = append(runs, run) }).
+		After(func(_ context.Context, info RunInfo) {
+			errs = append(errs, info.Err)
+			if info.Run == 3 {
+				cancel()
+			}
+		}).
+		Run(ctx, func(ctx context.Context) error {
+			switch len(errs) {
+			case 0:
+				return errJob
+			case 1:
+				panic("boom")
+			}
+			return nil
+		})
+
+	if len(runs) != 3 || runs[2] != 3 {
+		t.Fatalf("Expected runs [1 2 3], got %v", runs)
+	}
+	if !errors.Is(errs[0], errJob) {
+		t.Errorf("Expected job error, got %v", errs[0])
+	}
+	var panicErr *PanicError
+	if !errors.As(errs[1], &panicErr) || panicErr.Index != 2 || len(panicErr.Stack) == 0 {
+		t.Errorf("Expected PanicError for run 2, got %v", errs[1])
+	}
+	if errs[2] != nil {
+		t.Errorf("Expected schedule to keep running after a panic, got %v", errs[2])
+	}
+}
+
+func TestSchedule_Wrap(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var order []string
+	wrap := func(name string) func(Job) Job {
+		return func(next Job) Job {
+			return func(ctx context.Context) error {
+				order = append(order, name)
+				return next(ctx)
+			}
+		}
+	}
+	_ = Every(time.Millisecond).Immediate().Wrap(wrap("outer")).Wrap(wrap("inner")).
+		Run(ctx, func(ctx context.Context) error {
+			order = append(order, "job")
+			cancel()
+			return nil
+		})
+
+	if len(order) != 3 || order[0] != "outer" || order[1] != "inner" || order[2] != "job" {
+		t.Errorf("Expected [outer inner job], got %v", order)
+	}
+}
+
+func TestSchedule_InvalidInterval(t *testing.T) {
+	if err := Every(0).Run(context.Background(), nil); err == nil {
+		t.Error("Expected error for non-positive interval")
+	}
+}
+
+func TestChunkJob(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		total int
+	)
+	job := ChunkJob(func(ctx context.Context) ([]int, error) {
+		return []int{1, 2, 3, 4, 5}, nil
+	}, 2, 2, func(ctx context.Context, chunk []int) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, v := range chunk {
+			total += v
+		}
+		return nil
+	})
+	if err := job(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if total != 15 {
+		t.Errorf("Expected total 15, got %d", total)
+	}
+
+	errLoad := errors.New("load failed")
+	job = ChunkJob(func(ctx context.Context) ([]int, error) { return nil, errLoad }, 2, 1,
+		func(ctx context.Context, chunk []int) error { return nil })
+	if err := job(context.Background()); !errors.Is(err, errLoad) {
+		t.Errorf("Expected load error, got %v", err)
+	}
+}
@@ -0,0 +1,28 @@
+package slice
+
+import "slices"
+
+// CopyInto copies src into dst, reusing dst's backing array when it has enough capacity,
+// and returns the result with the length of src. The previous contents of dst are overwritten.
+// Typical use is dst = slice.CopyInto(dst, src) in a loop that reuses one buffer.
+func CopyInto[In any](dst, src []In) []In {
+	if cap(dst) < len(src) {
+		dst = make([]In, len(src))
+	}
+	dst = dst[:len(src)]
+	copy(dst, src)
+	return dst
+}
+
+// Grow returns the slice with capacity for at least n more elements, reallocating if needed.
+// The length and contents are unchanged. A negative n is treated as 0.
+func Grow[In any](input []In, n int) []In {
+	return slices.Grow(input, max(n, 0))
+}
+
+// Reset returns the slice truncated to length zero, keeping its capacity for reuse.
+// The elements are zeroed first so the slice does not keep them reachable.
+func Reset[In any](input []In) []In {
+	clear(input)
+	return input[:0]
+}
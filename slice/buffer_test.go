@@ -0,0 +1,56 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestCopyInto(t *testing.T) {
+	buf := make([]int, 0, 8)
+
+	got := slice.CopyInto(buf, []int{1, 2, 3})
+	if !slicesEqual(got, []int{1, 2, 3}) {
+		t.Errorf("CopyInto() = %v", got)
+	}
+	if &got[0] != &buf[:1][0] {
+		t.Error("CopyInto() should reuse dst capacity")
+	}
+
+	got = slice.CopyInto(got, []int{4})
+	if !slicesEqual(got, []int{4}) {
+		t.Errorf("CopyInto() shrink = %v", got)
+	}
+
+	small := make([]int, 1)
+	got = slice.CopyInto(small, []int{1, 2, 3})
+	if !slicesEqual(got, []int{1, 2, 3}) || cap(got) < 3 {
+		t.Errorf("CopyInto() grow = %v", got)
+	}
+
+	if got := slice.CopyInto[int](nil, nil); len(got) != 0 {
+		t.Errorf("CopyInto(nil, nil) = %v", got)
+	}
+}
+
+func TestGrow(t *testing.T) {
+	s := []int{1, 2}
+	got := slice.Grow(s, 10)
+	if !slicesEqual(got, []int{1, 2}) || cap(got)-len(got) < 10 {
+		t.Errorf("Grow() = %v (cap %d)", got, cap(got))
+	}
+	if got := slice.Grow(s, -1); !slicesEqual(got, s) {
+		t.Errorf("Grow(-1) = %v", got)
+	}
+}
+
+func TestReset(t *testing.T) {
+	buf := []*int{new(int), new(int)}
+	got := slice.Reset(buf)
+	if len(got) != 0 || cap(got) != 2 {
+		t.Errorf("Reset() len=%d cap=%d", len(got), cap(got))
+	}
+	if buf[0] != nil || buf[1] != nil {
+		t.Error("Reset() should zero the elements")
+	}
+}
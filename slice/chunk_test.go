@@ -73,6 +73,94 @@ func TestChunk(t *testing.T) {
 	}
 }
 
+func TestChunkBy(t *testing.T) {
+	gap := func(prev, cur int) bool { return cur-prev > 1 }
+
+	tests := []struct {
+		name     string
+		input    []int
+		boundary func(prev, cur int) bool
+		want     [][]int
+	}{
+		{
+			name:     "nil input",
+			input:    nil,
+			boundary: gap,
+			want:     nil,
+		},
+		{
+			name:     "empty input",
+			input:    []int{},
+			boundary: gap,
+			want:     [][]int{},
+		},
+		{
+			name:     "single element",
+			input:    []int{1},
+			boundary: gap,
+			want:     [][]int{{1}},
+		},
+		{
+			name:     "consecutive runs",
+			input:    []int{1, 2, 3, 7, 8, 10},
+			boundary: gap,
+			want:     [][]int{{1, 2, 3}, {7, 8}, {10}},
+		},
+		{
+			name:     "no boundary",
+			input:    []int{1, 2, 3},
+			boundary: gap,
+			want:     [][]int{{1, 2, 3}},
+		},
+		{
+			name:     "nil boundary",
+			input:    []int{1, 5, 9},
+			boundary: nil,
+			want:     [][]int{{1, 5, 9}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slice.ChunkBy(tt.input, tt.boundary)
+			if !slicesEqual2D(got, tt.want) {
+				t.Errorf("ChunkBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitN(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		n     int
+		want  [][]int
+	}{
+		{name: "nil input", input: nil, n: 3, want: nil},
+		{name: "empty input", input: []int{}, n: 3, want: [][]int{}},
+		{name: "even split", input: []int{1, 2, 3, 4, 5, 6}, n: 3, want: [][]int{{1, 2}, {3, 4}, {5, 6}}},
+		{name: "uneven split", input: []int{1, 2, 3, 4, 5, 6, 7}, n: 3, want: [][]int{{1, 2, 3}, {4, 5}, {6, 7}}},
+		{name: "more parts than elements", input: []int{1, 2}, n: 5, want: [][]int{{1}, {2}}},
+		{name: "zero parts", input: []int{1, 2, 3}, n: 0, want: [][]int{{1, 2, 3}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slice.SplitN(tt.input, tt.n)
+			if !slicesEqual2D(got, tt.want) {
+				t.Errorf("SplitN() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	parts := slice.SplitN([]int{1, 2, 3, 4}, 2)
+	_ = append(parts[0], 99)
+	if parts[1][0] != 3 {
+		t.Errorf("appending to a part overwrote the next one: %v", parts)
+	}
+}
+
 func slicesEqual2D[T comparable](a, b [][]T) bool {
 	if len(a) != len(b) {
 		return false
@@ -179,6 +267,53 @@ func TestForEachChunk(t *testing.T) {
 	})
 }
 
+func TestForEachChunk_Panic(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6}
+	handler := func(chunk []int) error {
+		if chunk[0] == 3 { // 2nd chunk {3, 4}
+			panic("boom")
+		}
+		return nil
+	}
+
+	for _, concurrency := range []int{1, 3} {
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			err := slice.ForEachChunk(input, 2, concurrency, handler)
+			var panicErr *slice.PanicError
+			if !errors.As(err, &panicErr) {
+				t.Fatalf("expected PanicError, got %v", err)
+			}
+			if panicErr.Index != 1 || panicErr.Value != "boom" {
+				t.Errorf("expected panic at chunk 1 with value boom, got %d %v", panicErr.Index, panicErr.Value)
+			}
+			if len(panicErr.Stack) == 0 {
+				t.Error("expected stack trace to be captured")
+			}
+		})
+	}
+
+	t.Run("error value", func(t *testing.T) {
+		errCause := errors.New("cause")
+		err := slice.ForEachChunk(input, 2, 2, func(chunk []int) error {
+			panic(errCause)
+		})
+		if !errors.Is(err, errCause) {
+			t.Errorf("expected panic error to unwrap to %v, got %v", errCause, err)
+		}
+	})
+
+	t.Run("repanic", func(t *testing.T) {
+		defer func() {
+			r := recover()
+			if _, ok := r.(*slice.PanicError); !ok {
+				t.Errorf("expected re-panic with *PanicError, got %v", r)
+			}
+		}()
+		_ = slice.ForEachChunk(input, 2, 3, handler, slice.WithRepanic())
+		t.Error("expected ForEachChunk to panic")
+	})
+}
+
 func slicesEqual[T comparable](a, b []T) bool {
 	if len(a) != len(b) {
 		return false
@@ -0,0 +1,146 @@
+package slice
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// ConcurrentCollectorContext is a CollectorContext whose handler may fan work out to goroutines.
+// EmitAt, Go, Set and Get are safe to call from any goroutine. The remaining methods,
+// including SetValue, Continue and Stop, must only be called from the handler itself.
+type ConcurrentCollectorContext[In, Out any] interface {
+	CollectorContext[In, Out]
+	// EmitAt adds value to the result at the position of the input element at index.
+	// Values emitted for the same index keep their emission order.
+	// It panics if index is out of range.
+	EmitAt(index int, value Out)
+	// Go runs fn in a new goroutine tied to the current element. Collection waits for
+	// all such goroutines before returning. Errors and panics from fn are reported
+	// against the current element. When called from a goroutine started by Go, the
+	// current element is the one the handler is processing at the time of the call.
+	Go(fn func() error)
+}
+
+// concurrentCollectorContextImpl is a concrete implementation of ConcurrentCollectorContext.
+type concurrentCollectorContextImpl[In, Out any] struct {
+	*collectorContextImpl[In, Out]
+	mu    sync.Mutex
+	wg    sync.WaitGroup
+	slots [][]Out
+	errs  SliceError[In]
+}
+
+// EmitAt implements the EmitAt method of ConcurrentCollectorContext.
+func (c *concurrentCollectorContextImpl[In, Out]) EmitAt(index int, value Out) {
+	if index < 0 || index >= len(c.slots) {
+		panic(fmt.Sprintf("slice: EmitAt index %d out of range [0:%d]", index, len(c.slots)))
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.slots[index] = append(c.slots[index], value)
+}
+
+// Go implements the Go method of ConcurrentCollectorContext.
+func (c *concurrentCollectorContextImpl[In, Out]) Go(fn func() error) {
+	c.mu.Lock()
+	index := c.currentIndex
+	c.mu.Unlock()
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		if err := safeCall(index, fn); err != nil {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			c.errs = append(c.errs, &ElemError[In]{Index: index, Value: c.elemGetter(index), Err: err})
+		}
+	}()
+}
+
+// Set implements the Set method of CollectorContext.
+func (c *concurrentCollectorContextImpl[In, Out]) Set(key string, value any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.collectorContextImpl.Set(key, value)
+}
+
+// Get implements the Get method of CollectorContext.
+func (c *concurrentCollectorContextImpl[In, Out]) Get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.collectorContextImpl.Get(key)
+}
+
+// flatten returns the values emitted so far, in input order.
+func (c *concurrentCollectorContextImpl[In, Out]) flatten() []Out {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var result []Out
+	for _, values := range c.slots {
+		result = append(result, values...)
+	}
+	return result
+}
+
+// CollectConcurrent works like Collect, but the handler may spawn goroutines with Go and
+// deliver results from them with EmitAt. Results are ordered by the index they were
+// emitted at, regardless of completion order. Values set with SetValue are placed at
+// the current element's index.
+// Errors from Continue, Stop and failed Go functions are returned as a SliceError
+// ordered by index. Stop prevents the handler from being called for further elements,
+// but goroutines already started are still awaited. If the handler panics, the panic
+// propagates once goroutines already started have finished.
+func CollectConcurrent[In, Out any](input []In, handler func(c ConcurrentCollectorContext[In, Out]), opts ...Option) ([]Out, error) {
+	if len(input) == 0 || handler == nil {
+		return nil, nil
+	}
+	var stats OpStats
+	defer applyOptions(opts).observe("CollectConcurrent", len(input), &stats)()
+
+	c := &concurrentCollectorContextImpl[In, Out]{
+		collectorContextImpl: newCollectorContext[In, Out](input),
+		slots:                make([][]Out, len(input)),
+	}
+	c.resultGetter = c.flatten
+	// Await goroutines started with Go even if the handler panics, so that none of
+	// them is still running once CollectConcurrent has returned.
+	defer c.wg.Wait()
+
+	var errs SliceError[In]
+	for i := range input {
+		c.stopped = false
+		c.continued = false
+		c.errOnStopped = nil
+		c.errOnContinued = nil
+		c.hasValue = false
+		c.mu.Lock()
+		c.currentIndex = i // Go may read it from other goroutines
+		c.mu.Unlock()
+
+		c.invoke(func() { handler(c) })
+
+		if c.stopped {
+			errs = append(errs, c.errOnStopped...)
+			break
+		}
+		if c.continued {
+			errs = append(errs, c.errOnContinued...)
+			continue
+		}
+		if c.hasValue {
+			c.EmitAt(i, c.currentValue)
+		}
+	}
+	c.wg.Wait()
+
+	errs = append(errs, c.errs...)
+	stats.Errors = len(errs)
+	result := c.flatten()
+	if len(errs) == 0 {
+		return result, nil
+	}
+	slices.SortStableFunc(errs, func(a, b *ElemError[In]) int {
+		return a.Index - b.Index
+	})
+	return result, errs
+}
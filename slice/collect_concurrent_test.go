@@ -0,0 +1,181 @@
+package slice_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestCollectConcurrent(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5}
+
+	got, err := slice.CollectConcurrent(input, func(c slice.ConcurrentCollectorContext[int, int]) {
+		i, v := c.CurrentElem()
+		c.Go(func() error {
+			// Later elements finish first.
+			time.Sleep(time.Duration(len(input)-i) * time.Millisecond)
+			c.EmitAt(i, v*10)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slicesEqual(got, []int{10, 20, 30, 40, 50}) {
+		t.Errorf("CollectConcurrent() = %v", got)
+	}
+}
+
+func TestCollectConcurrent_MixedEmits(t *testing.T) {
+	got, err := slice.CollectConcurrent([]string{"a", "b", "c"}, func(c slice.ConcurrentCollectorContext[string, string]) {
+		i, v := c.CurrentElem()
+		switch v {
+		case "a":
+			c.SetValue("a")
+		case "b":
+			c.Continue()
+		case "c":
+			c.Go(func() error {
+				c.EmitAt(i, "c1")
+				c.EmitAt(i, "c2")
+				c.EmitAt(1, "from-c")
+				return nil
+			})
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slicesEqual(got, []string{"a", "from-c", "c1", "c2"}) {
+		t.Errorf("CollectConcurrent() = %v", got)
+	}
+}
+
+func TestCollectConcurrent_Errors(t *testing.T) {
+	errOdd := errors.New("odd")
+
+	got, err := slice.CollectConcurrent([]int{1, 2, 3, 4, 5, 6}, func(c slice.ConcurrentCollectorContext[int, int]) {
+		i, v := c.CurrentElem()
+		if v == 5 {
+			c.Stop(errors.New("stop"))
+		}
+		c.Go(func() error {
+			if v == 2 {
+				panic("boom")
+			}
+			if v%2 == 1 {
+				return errOdd
+			}
+			c.EmitAt(i, v)
+			return nil
+		})
+	})
+
+	if !slicesEqual(got, []int{4}) {
+		t.Errorf("CollectConcurrent() = %v, want [4]", got)
+	}
+	var sErr slice.SliceError[int]
+	if !errors.As(err, &sErr) {
+		t.Fatalf("expected SliceError, got %T", err)
+	}
+	var indexes []int
+	for _, e := range sErr {
+		indexes = append(indexes, e.Index)
+	}
+	if !slicesEqual(indexes, []int{0, 1, 2, 4}) {
+		t.Errorf("error indexes = %v, want [0 1 2 4]", indexes)
+	}
+	var panicErr *slice.PanicError
+	if !errors.As(sErr[1].Err, &panicErr) || panicErr.Value != "boom" {
+		t.Errorf("expected PanicError for index 1, got %v", sErr[1].Err)
+	}
+	if !errors.Is(err, errOdd) {
+		t.Errorf("expected errOdd in %v", err)
+	}
+}
+
+func TestCollectConcurrent_Metadata(t *testing.T) {
+	_, err := slice.CollectConcurrent([]int{1, 2, 3}, func(c slice.ConcurrentCollectorContext[int, int]) {
+		_, v := c.CurrentElem()
+		c.Go(func() error {
+			c.Set("last", v)
+			_, _ = c.Get("last")
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCollectConcurrent_EmitAtOutOfRange(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for out-of-range EmitAt")
+		}
+	}()
+	_, _ = slice.CollectConcurrent([]int{1}, func(c slice.ConcurrentCollectorContext[int, int]) {
+		c.EmitAt(1, 0)
+	})
+}
+
+func TestCollectConcurrent_HandlerPanicAwaitsGoroutines(t *testing.T) {
+	var finished atomic.Bool
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected the handler panic to propagate")
+			}
+		}()
+		_, _ = slice.CollectConcurrent([]int{1, 2}, func(c slice.ConcurrentCollectorContext[int, int]) {
+			i, _ := c.CurrentElem()
+			if i == 0 {
+				c.Go(func() error {
+					time.Sleep(20 * time.Millisecond)
+					finished.Store(true)
+					return nil
+				})
+				return
+			}
+			panic("boom")
+		})
+	}()
+	if !finished.Load() {
+		t.Error("expected goroutines started with Go to finish before the panic propagates")
+	}
+}
+
+func TestCollectConcurrent_NestedGo(t *testing.T) {
+	input := make([]int, 50)
+	for i := range input {
+		input[i] = i
+	}
+	got, err := slice.CollectConcurrent(input, func(c slice.ConcurrentCollectorContext[int, int]) {
+		i, v := c.CurrentElem()
+		c.Go(func() error {
+			// Go is safe to call from goroutines it started.
+			c.Go(func() error {
+				c.EmitAt(i, v*2)
+				return nil
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := slice.Map(input, func(v int) int { return v * 2 })
+	if !slicesEqual(got, want) {
+		t.Errorf("CollectConcurrent() = %v, want %v", got, want)
+	}
+}
+
+func TestCollectConcurrent_Empty(t *testing.T) {
+	got, err := slice.CollectConcurrent[int, int](nil, func(c slice.ConcurrentCollectorContext[int, int]) {})
+	if got != nil || err != nil {
+		t.Errorf("CollectConcurrent(nil) = %v, %v", got, err)
+	}
+}
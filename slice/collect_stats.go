@@ -0,0 +1,31 @@
+package slice
+
+import (
+	"context"
+	"time"
+)
+
+// CollectStats summarizes a Collect run, for progress logging.
+type CollectStats struct {
+	// Processed is the number of elements passed to the handler.
+	Processed int
+	// Skipped is the number of processed elements that added nothing to the result,
+	// because the handler called Continue or Stop, or did not call SetValue.
+	Skipped int
+	// Reason is why the run stopped, or StopNone if it visited every element.
+	Reason StopReason
+	// StopIndex is the index of the element at which the run stopped, or -1 if it did not stop.
+	StopIndex int
+	// Duration is the wall time of the run.
+	Duration time.Duration
+}
+
+// CollectWithStats is like Collect, but also returns statistics about the run.
+func CollectWithStats[In, Out any](input []In, handler func(c CollectorContext[In, Out]), opts ...Option) ([]Out, CollectStats, error) {
+	var stats CollectStats
+	start := time.Now()
+	result, res, err := collect(context.Background(), input, handler, opts, &stats)
+	stats.Reason, stats.StopIndex = res.Reason, res.StopIndex
+	stats.Duration = time.Since(start)
+	return result, stats, err
+}
@@ -0,0 +1,53 @@
+package slice_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestCollectWithStats(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6}
+	got, stats, err := slice.CollectWithStats(input, func(c slice.CollectorContext[int, int]) {
+		_, v := c.CurrentElem()
+		switch {
+		case v == 5:
+			c.Stop(errors.New("bad input"))
+		case v%2 == 0:
+			c.Continue()
+		}
+		c.SetValue(v)
+	})
+	if err == nil {
+		t.Error("expected an error from Stop")
+	}
+	if !slicesEqual(got, []int{1, 3}) {
+		t.Errorf("CollectWithStats() = %v, want [1 3]", got)
+	}
+	if stats.Processed != 5 || stats.Skipped != 3 {
+		t.Errorf("Processed = %d, Skipped = %d, want 5, 3", stats.Processed, stats.Skipped)
+	}
+	if stats.Reason != slice.StopFailed || stats.StopIndex != 4 {
+		t.Errorf("Reason = %v, StopIndex = %d, want failed, 4", stats.Reason, stats.StopIndex)
+	}
+	if stats.Duration < 0 {
+		t.Errorf("Duration = %v, want >= 0", stats.Duration)
+	}
+}
+
+func TestCollectWithStats_Complete(t *testing.T) {
+	_, stats, err := slice.CollectWithStats([]string{"a", "", "b"}, func(c slice.CollectorContext[string, string]) {
+		if _, v := c.CurrentElem(); v != "" {
+			c.SetValue(v)
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := slice.CollectStats{Processed: 3, Skipped: 1, Reason: slice.StopNone, StopIndex: -1}
+	stats.Duration = 0
+	if stats != want {
+		t.Errorf("CollectWithStats() stats = %+v, want %+v", stats, want)
+	}
+}
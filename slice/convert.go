@@ -0,0 +1,59 @@
+package slice
+
+import (
+	"fmt"
+
+	"github.com/cirius-go/devutil/internal/numeric"
+)
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number = numeric.Number
+
+// Convert converts each element of a numeric slice to another numeric type using Go's
+// conversion rules, which silently truncate or wrap out-of-range values.
+// Use ConvertChecked when values may not fit. Returns nil if the input is nil.
+func Convert[In, Out Number](input []In) []Out {
+	if input == nil {
+		return nil
+	}
+	result := make([]Out, len(input))
+	for i, v := range input {
+		result[i] = Out(v)
+	}
+	return result
+}
+
+// ConvertChecked converts each element of a numeric slice to another numeric type,
+// reporting every element that cannot be represented exactly — because it overflows,
+// changes sign, or loses precision — as a SliceError. NaN converts exactly between
+// floating-point types, and is reported when converted to an integer type.
+// The result has the same length as the input; failing elements are left as zero.
+func ConvertChecked[In, Out Number](input []In) ([]Out, error) {
+	if input == nil {
+		return nil, nil
+	}
+	var (
+		result = make([]Out, len(input))
+		errs   SliceError[In]
+	)
+	for i, v := range input {
+		out := Out(v)
+		if v != v && out != out { // NaN converts exactly to NaN
+			result[i] = out
+			continue
+		}
+		if In(out) != v || (v < 0) != (out < 0) {
+			errs = append(errs, &ElemError[In]{
+				Index: i,
+				Value: v,
+				Err:   fmt.Errorf("cannot convert %v to %T without loss", v, out),
+			})
+			continue
+		}
+		result[i] = out
+	}
+	if len(errs) == 0 {
+		return result, nil
+	}
+	return result, errs
+}
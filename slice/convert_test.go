@@ -0,0 +1,63 @@
+package slice_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestConvert(t *testing.T) {
+	got := slice.Convert[int, float64]([]int{1, -2, 3})
+	if !slicesEqual(got, []float64{1, -2, 3}) {
+		t.Errorf("Convert() = %v", got)
+	}
+	wrapped := slice.Convert[int, uint8]([]int{256, 257})
+	if !slicesEqual(wrapped, []uint8{0, 1}) {
+		t.Errorf("Convert() should wrap like a Go conversion, got %v", wrapped)
+	}
+	if slice.Convert[int, int64](nil) != nil {
+		t.Error("Convert() of nil should return nil")
+	}
+}
+
+func TestConvertChecked(t *testing.T) {
+	input := []int64{1, math.MaxInt32 + 1, -1, 42}
+	got, err := slice.ConvertChecked[int64, int32](input)
+	if !slicesEqual(got, []int32{1, 0, -1, 42}) {
+		t.Errorf("ConvertChecked() = %v", got)
+	}
+	var sliceErr slice.SliceError[int64]
+	if !errors.As(err, &sliceErr) || len(sliceErr) != 1 || sliceErr[0].Index != 1 {
+		t.Errorf("ConvertChecked() expected overflow at index 1, got %v", err)
+	}
+
+	_, err = slice.ConvertChecked[int, uint]([]int{1, -1})
+	var intErr slice.SliceError[int]
+	if !errors.As(err, &intErr) || len(intErr) != 1 || intErr[0].Index != 1 {
+		t.Errorf("ConvertChecked() expected sign error at index 1, got %v", err)
+	}
+
+	_, err = slice.ConvertChecked[float64, int]([]float64{2, 2.5})
+	var floatErr slice.SliceError[float64]
+	if !errors.As(err, &floatErr) || len(floatErr) != 1 || floatErr[0].Index != 1 {
+		t.Errorf("ConvertChecked() expected precision loss at index 1, got %v", err)
+	}
+
+	if _, err := slice.ConvertChecked[int8, int64]([]int8{-128, 127}); err != nil {
+		t.Errorf("ConvertChecked() unexpected error: %v", err)
+	}
+
+	// NaN round-trips between float types but has no integer representation.
+	nan, err := slice.ConvertChecked[float64, float32]([]float64{math.NaN(), 1})
+	if err != nil || !math.IsNaN(float64(nan[0])) || nan[1] != 1 {
+		t.Errorf("ConvertChecked() of NaN = %v, %v", nan, err)
+	}
+	if _, err := slice.ConvertChecked[float64, float64]([]float64{math.NaN()}); err != nil {
+		t.Errorf("ConvertChecked() of NaN to float64 unexpected error: %v", err)
+	}
+	if _, err := slice.ConvertChecked[float64, int]([]float64{math.NaN()}); err == nil {
+		t.Error("ConvertChecked() expected NaN to int to fail")
+	}
+}
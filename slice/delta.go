@@ -0,0 +1,36 @@
+package slice
+
+// Delta compares two versions of a collection, matching elements by keyFn.
+// It returns the elements of newer whose key is absent from older (added), the elements
+// of older whose key is absent from newer (removed), and the elements of newer whose key
+// exists in older but which eq reports as different (updated).
+// added and updated follow the order of newer; removed follows the order of older.
+// If eq is nil, elements with matching keys are considered unchanged. When a key appears
+// more than once in older, its last occurrence is used for comparison.
+func Delta[T any, K comparable](older, newer []T, keyFn func(T) K, eq func(a, b T) bool) (added, removed, updated []T) {
+	if keyFn == nil {
+		return nil, nil, nil
+	}
+	oldByKey := make(map[K]T, len(older))
+	for _, item := range older {
+		oldByKey[keyFn(item)] = item
+	}
+	newKeys := make(map[K]struct{}, len(newer))
+	for _, item := range newer {
+		k := keyFn(item)
+		newKeys[k] = struct{}{}
+		prev, ok := oldByKey[k]
+		switch {
+		case !ok:
+			added = append(added, item)
+		case eq != nil && !eq(prev, item):
+			updated = append(updated, item)
+		}
+	}
+	for _, item := range older {
+		if _, ok := newKeys[keyFn(item)]; !ok {
+			removed = append(removed, item)
+		}
+	}
+	return added, removed, updated
+}
@@ -0,0 +1,35 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestDelta(t *testing.T) {
+	older := []user{{1, "a"}, {2, "b"}, {3, "c"}}
+	newer := []user{{4, "d"}, {3, "c"}, {2, "B"}}
+	byID := func(u user) int { return u.ID }
+	eq := func(a, b user) bool { return a == b }
+
+	added, removed, updated := slice.Delta(older, newer, byID, eq)
+	if !slicesEqual(added, []user{{4, "d"}}) {
+		t.Errorf("Delta() added = %v", added)
+	}
+	if !slicesEqual(removed, []user{{1, "a"}}) {
+		t.Errorf("Delta() removed = %v", removed)
+	}
+	if !slicesEqual(updated, []user{{2, "B"}}) {
+		t.Errorf("Delta() updated = %v", updated)
+	}
+
+	_, _, updated = slice.Delta(older, newer, byID, nil)
+	if len(updated) != 0 {
+		t.Errorf("Delta() with nil eq updated = %v, want none", updated)
+	}
+
+	added, removed, updated = slice.Delta(nil, older, byID, eq)
+	if !slicesEqual(added, older) || removed != nil || updated != nil {
+		t.Errorf("Delta() from empty = %v, %v, %v", added, removed, updated)
+	}
+}
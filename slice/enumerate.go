@@ -0,0 +1,20 @@
+package slice
+
+import "iter"
+
+// Enumerate returns an iterator over the index/value pairs of the slice, starting at index 0.
+func Enumerate[In any](input []In) iter.Seq2[int, In] {
+	return EnumerateFrom(input, 0)
+}
+
+// EnumerateFrom returns an iterator over the values of the slice paired with a
+// counter that starts at start, e.g. 1 for human-readable numbering.
+func EnumerateFrom[In any](input []In, start int) iter.Seq2[int, In] {
+	return func(yield func(int, In) bool) {
+		for i, v := range input {
+			if !yield(start+i, v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestEnumerate(t *testing.T) {
+	input := []string{"a", "b", "c"}
+
+	var idx []int
+	var vals []string
+	for i, v := range slice.Enumerate(input) {
+		idx = append(idx, i)
+		vals = append(vals, v)
+	}
+	if !slicesEqual(idx, []int{0, 1, 2}) || !slicesEqual(vals, input) {
+		t.Errorf("Enumerate() = %v %v", idx, vals)
+	}
+
+	for range slice.Enumerate([]int(nil)) {
+		t.Error("expected no iterations for nil slice")
+	}
+}
+
+func TestEnumerateFrom(t *testing.T) {
+	input := []string{"a", "b", "c"}
+
+	tests := []struct {
+		name  string
+		start int
+		stop  int
+		want  []int
+	}{
+		{name: "from one", start: 1, stop: 100, want: []int{1, 2, 3}},
+		{name: "negative start", start: -1, stop: 100, want: []int{-1, 0, 1}},
+		{name: "early break", start: 10, stop: 11, want: []int{10, 11}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for i := range slice.EnumerateFrom(input, tt.start) {
+				got = append(got, i)
+				if i == tt.stop {
+					break
+				}
+			}
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("EnumerateFrom() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
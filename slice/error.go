@@ -3,6 +3,8 @@ package slice
 import (
 	"errors"
 	"strings"
+
+	"github.com/cirius-go/devutil/internal/panicerr"
 )
 
 // ElemError represents an error related to slice elements.
@@ -74,3 +76,18 @@ func (e SliceError[In]) OriginAt(index int) error {
 	}
 	return e[index].Err
 }
+
+// PanicError represents a panic recovered from a handler. Its Index is the position
+// of the chunk or element whose handler panicked.
+// It is the same type as the PanicError of the record, async, sched and flight packages.
+type PanicError = panicerr.Error
+
+// safeCall runs fn, converting a panic into a *PanicError tagged with index.
+func safeCall(index int, fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicerr.New("handler at index", index, r)
+		}
+	}()
+	return fn()
+}
@@ -0,0 +1,26 @@
+package slice
+
+// Fill sets every element of the slice to value, in place.
+func Fill[In any](input []In, value In) {
+	for i := range input {
+		input[i] = value
+	}
+}
+
+// FillBy sets every element of the slice to the value returned by factory for its index, in place.
+func FillBy[In any](input []In, factory func(i int) In) {
+	if factory == nil {
+		return
+	}
+	for i := range input {
+		input[i] = factory(i)
+	}
+}
+
+// Make creates a slice of n elements, each initialized by factory with its index.
+// Returns an empty slice if n <= 0. If factory is nil, elements are zero values.
+func Make[In any](n int, factory func(i int) In) []In {
+	result := make([]In, max(n, 0))
+	FillBy(result, factory)
+	return result
+}
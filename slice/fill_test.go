@@ -0,0 +1,35 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestFill(t *testing.T) {
+	buf := make([]int, 3)
+	slice.Fill(buf, 7)
+	if !slicesEqual(buf, []int{7, 7, 7}) {
+		t.Errorf("Fill() = %v", buf)
+	}
+	slice.Fill[int](nil, 1) // must not panic
+}
+
+func TestFillBy(t *testing.T) {
+	buf := make([]int, 4)
+	slice.FillBy(buf, func(i int) int { return i * i })
+	if !slicesEqual(buf, []int{0, 1, 4, 9}) {
+		t.Errorf("FillBy() = %v", buf)
+	}
+}
+
+func TestMake(t *testing.T) {
+	got := slice.Make(3, func(i int) user { return user{ID: i + 1} })
+	want := []user{{ID: 1}, {ID: 2}, {ID: 3}}
+	if !slicesEqual(got, want) {
+		t.Errorf("Make() = %v, want %v", got, want)
+	}
+	if got := slice.Make[int](-1, nil); got == nil || len(got) != 0 {
+		t.Errorf("Make() with n < 0 = %#v, want empty slice", got)
+	}
+}
@@ -0,0 +1,41 @@
+package slice
+
+// GroupBy groups the elements of the slice by the key returned by keyFn.
+// Elements keep their input order within each group.
+// Returns nil if the input slice is empty.
+func GroupBy[In any, K comparable](input []In, keyFn func(item In) K) map[K][]In {
+	if len(input) == 0 || keyFn == nil {
+		return nil
+	}
+	groups := make(map[K][]In)
+	for _, item := range input {
+		k := keyFn(item)
+		groups[k] = append(groups[k], item)
+	}
+	return groups
+}
+
+// GroupByMulti groups the elements of the slice under every key returned by keysFn,
+// so one element can belong to several groups. An element is added at most once per
+// group, even if keysFn repeats a key, and elements with no keys are left out.
+// Elements keep their input order within each group.
+// Returns nil if the input slice is empty.
+func GroupByMulti[In any, K comparable](input []In, keysFn func(item In) []K) map[K][]In {
+	if len(input) == 0 || keysFn == nil {
+		return nil
+	}
+	var (
+		groups    = make(map[K][]In)
+		lastAdded = make(map[K]int) // index of the last element added to each group
+	)
+	for i, item := range input {
+		for _, k := range keysFn(item) {
+			if last, ok := lastAdded[k]; ok && last == i {
+				continue
+			}
+			lastAdded[k] = i
+			groups[k] = append(groups[k], item)
+		}
+	}
+	return groups
+}
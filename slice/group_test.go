@@ -0,0 +1,54 @@
+package slice_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestGroupBy(t *testing.T) {
+	words := []string{"apple", "bob", "avocado", "cat", "banana"}
+	got := slice.GroupBy(words, func(w string) byte { return w[0] })
+	want := map[byte][]string{
+		'a': {"apple", "avocado"},
+		'b': {"bob", "banana"},
+		'c': {"cat"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupBy() = %v, want %v", got, want)
+	}
+	if got := slice.GroupBy(nil, func(w string) byte { return 0 }); got != nil {
+		t.Errorf("GroupBy(nil) = %v, want nil", got)
+	}
+}
+
+func TestGroupByMulti(t *testing.T) {
+	type post struct {
+		Title string
+		Tags  []string
+	}
+	posts := []post{
+		{Title: "p1", Tags: []string{"go", "db"}},
+		{Title: "p2", Tags: []string{"go"}},
+		{Title: "p3", Tags: nil},
+		{Title: "p4", Tags: []string{"db", "db"}},
+	}
+
+	got := slice.GroupByMulti(posts, func(p post) []string { return p.Tags })
+	titles := make(map[string][]string, len(got))
+	for tag, ps := range got {
+		titles[tag] = slice.Pluck(ps, func(p post) string { return p.Title })
+	}
+	want := map[string][]string{
+		"go": {"p1", "p2"},
+		"db": {"p1", "p4"},
+	}
+	if !reflect.DeepEqual(titles, want) {
+		t.Errorf("GroupByMulti() = %v, want %v", titles, want)
+	}
+
+	if got := slice.GroupByMulti([]post{}, func(p post) []string { return p.Tags }); got != nil {
+		t.Errorf("GroupByMulti(empty) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,65 @@
+package slice
+
+// At returns the element at index i and true, or the zero value and false if i is out of range.
+// A negative i counts from the end of the slice, so -1 is the last element.
+func At[In any](input []In, i int) (In, bool) {
+	if i < 0 {
+		i += len(input)
+	}
+	if i < 0 || i >= len(input) {
+		var zero In
+		return zero, false
+	}
+	return input[i], true
+}
+
+// AtOr returns the element at index i, or fallback if i is out of range.
+// A negative i counts from the end of the slice.
+func AtOr[In any](input []In, i int, fallback In) In {
+	if v, ok := At(input, i); ok {
+		return v
+	}
+	return fallback
+}
+
+// First returns the first element of the slice and true, or the zero value and false if it is empty.
+func First[In any](input []In) (In, bool) {
+	return At(input, 0)
+}
+
+// Last returns the last element of the slice and true, or the zero value and false if it is empty.
+func Last[In any](input []In) (In, bool) {
+	return At(input, -1)
+}
+
+// SafeSlice returns input[from:to] with both bounds clamped to [0, len(input)], instead
+// of panicking on out-of-range values. If from >= to after clamping, it returns an empty slice.
+// The result shares memory with the input but has its capacity capped.
+func SafeSlice[In any](input []In, from, to int) []In {
+	from = min(max(from, 0), len(input))
+	to = min(max(to, from), len(input))
+	return input[from:to:to]
+}
+
+// ClampIndex returns i clamped to the valid indexes of a slice of the given length,
+// [0, length-1]. It returns -1 if length <= 0.
+func ClampIndex(i, length int) int {
+	if length <= 0 {
+		return -1
+	}
+	return min(max(i, 0), length-1)
+}
+
+// SplitAt splits the slice into input[:i] and input[i:], with i clamped to [0, len(input)].
+// Both halves share memory with the input; the left half has its capacity capped so that
+// appending to it does not overwrite the right half.
+func SplitAt[In any](input []In, i int) (left, right []In) {
+	i = min(max(i, 0), len(input))
+	return input[:i:i], input[i:]
+}
+
+// Halve splits the slice into two halves. When the length is odd, the left half gets
+// the extra element. Both halves share memory with the input, as with SplitAt.
+func Halve[In any](input []In) (left, right []In) {
+	return SplitAt(input, (len(input)+1)/2)
+}
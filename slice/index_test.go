@@ -0,0 +1,158 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestAt(t *testing.T) {
+	input := []int{10, 20, 30}
+
+	tests := []struct {
+		name   string
+		index  int
+		want   int
+		wantOk bool
+	}{
+		{name: "first", index: 0, want: 10, wantOk: true},
+		{name: "middle", index: 1, want: 20, wantOk: true},
+		{name: "last negative", index: -1, want: 30, wantOk: true},
+		{name: "first negative", index: -3, want: 10, wantOk: true},
+		{name: "past end", index: 3, want: 0, wantOk: false},
+		{name: "before start", index: -4, want: 0, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := slice.At(input, tt.index)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("At(%d) = (%v, %v), want (%v, %v)", tt.index, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestAtOr(t *testing.T) {
+	input := []string{"a", "b"}
+	if got := slice.AtOr(input, -1, "z"); got != "b" {
+		t.Errorf("AtOr(-1) = %q, want %q", got, "b")
+	}
+	if got := slice.AtOr(input, 5, "z"); got != "z" {
+		t.Errorf("AtOr(5) = %q, want %q", got, "z")
+	}
+	if got := slice.AtOr(nil, 0, "z"); got != "z" {
+		t.Errorf("AtOr(nil) = %q, want %q", got, "z")
+	}
+}
+
+func TestFirstLast(t *testing.T) {
+	input := []int{1, 2, 3}
+	if v, ok := slice.First(input); v != 1 || !ok {
+		t.Errorf("First() = (%v, %v), want (1, true)", v, ok)
+	}
+	if v, ok := slice.Last(input); v != 3 || !ok {
+		t.Errorf("Last() = (%v, %v), want (3, true)", v, ok)
+	}
+	if _, ok := slice.First([]int{}); ok {
+		t.Error("First() on empty slice should return false")
+	}
+	if _, ok := slice.Last[int](nil); ok {
+		t.Error("Last() on nil slice should return false")
+	}
+}
+
+func TestSafeSlice(t *testing.T) {
+	input := []int{0, 1, 2, 3, 4}
+
+	tests := []struct {
+		name     string
+		from, to int
+		want     []int
+	}{
+		{name: "in range", from: 1, to: 3, want: []int{1, 2}},
+		{name: "negative from", from: -5, to: 2, want: []int{0, 1}},
+		{name: "to past end", from: 3, to: 100, want: []int{3, 4}},
+		{name: "from past end", from: 10, to: 20, want: []int{}},
+		{name: "from after to", from: 4, to: 2, want: []int{}},
+		{name: "whole slice", from: -1, to: 99, want: input},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slice.SafeSlice(input, tt.from, tt.to); !slicesEqual(got, tt.want) {
+				t.Errorf("SafeSlice(%d, %d) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+
+	window := slice.SafeSlice(input, 0, 2)
+	_ = append(window, 99)
+	if input[2] != 2 {
+		t.Errorf("SafeSlice() result must not alias input on append, got %v", input)
+	}
+	if got := slice.SafeSlice[int](nil, 0, 5); len(got) != 0 {
+		t.Errorf("SafeSlice(nil) = %v", got)
+	}
+}
+
+func TestClampIndex(t *testing.T) {
+	tests := []struct {
+		i, length, want int
+	}{
+		{i: 2, length: 5, want: 2},
+		{i: -3, length: 5, want: 0},
+		{i: 9, length: 5, want: 4},
+		{i: 0, length: 0, want: -1},
+	}
+	for _, tt := range tests {
+		if got := slice.ClampIndex(tt.i, tt.length); got != tt.want {
+			t.Errorf("ClampIndex(%d, %d) = %d, want %d", tt.i, tt.length, got, tt.want)
+		}
+	}
+}
+
+func TestSplitAt(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	tests := []struct {
+		name        string
+		i           int
+		left, right []int
+	}{
+		{name: "middle", i: 1, left: []int{1}, right: []int{2, 3, 4}},
+		{name: "negative", i: -2, left: []int{}, right: []int{1, 2, 3, 4}},
+		{name: "past end", i: 10, left: []int{1, 2, 3, 4}, right: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left, right := slice.SplitAt(input, tt.i)
+			if !slicesEqual(left, tt.left) || !slicesEqual(right, tt.right) {
+				t.Errorf("SplitAt(%d) = %v, %v, want %v, %v", tt.i, left, right, tt.left, tt.right)
+			}
+		})
+	}
+
+	left, _ := slice.SplitAt(input, 2)
+	_ = append(left, 99)
+	if input[2] != 3 {
+		t.Errorf("appending to the left half overwrote the input: %v", input)
+	}
+}
+
+func TestHalve(t *testing.T) {
+	tests := []struct {
+		input       []int
+		left, right []int
+	}{
+		{input: []int{1, 2, 3, 4}, left: []int{1, 2}, right: []int{3, 4}},
+		{input: []int{1, 2, 3}, left: []int{1, 2}, right: []int{3}},
+		{input: []int{1}, left: []int{1}, right: []int{}},
+		{input: nil, left: []int{}, right: []int{}},
+	}
+	for _, tt := range tests {
+		left, right := slice.Halve(tt.input)
+		if !slicesEqual(left, tt.left) || !slicesEqual(right, tt.right) {
+			t.Errorf("Halve(%v) = %v, %v, want %v, %v", tt.input, left, right, tt.left, tt.right)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package slice
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Join formats each element of the slice with format and concatenates the results,
+// placing sep between them. If format is nil, fmt.Sprint is used.
+func Join[In any](input []In, sep string, format func(In) string) string {
+	if format == nil {
+		format = func(v In) string { return fmt.Sprint(v) }
+	}
+	var b strings.Builder
+	for i, v := range input {
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(format(v))
+	}
+	return b.String()
+}
@@ -0,0 +1,40 @@
+package slice_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestJoin(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []int
+		sep    string
+		format func(int) string
+		want   string
+	}{
+		{name: "default format", input: []int{1, 2, 3}, sep: ", ", want: "1, 2, 3"},
+		{name: "custom format", input: []int{1, 2}, sep: "|", format: func(i int) string { return "#" + strconv.Itoa(i) }, want: "#1|#2"},
+		{name: "single element", input: []int{7}, sep: ", ", want: "7"},
+		{name: "empty slice", input: []int{}, sep: ", ", want: ""},
+		{name: "nil slice", input: nil, sep: ", ", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slice.Join(tt.input, tt.sep, tt.format); got != tt.want {
+				t.Errorf("Join() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoin_Struct(t *testing.T) {
+	users := []user{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob"}}
+	got := slice.Join(users, " and ", func(u user) string { return u.Name })
+	if got != "Alice and Bob" {
+		t.Errorf("Join() = %q, want %q", got, "Alice and Bob")
+	}
+}
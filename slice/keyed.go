@@ -0,0 +1,153 @@
+package slice
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+// ErrSkipped is reported by ForEachKeyed and MapParallelKeyed for items that were not
+// processed because an earlier item with the same key failed.
+var ErrSkipped = errors.New("slice: skipped after earlier failure for the same key")
+
+// ForEachKeyed processes the slice with up to concurrency workers, guaranteeing that
+// items sharing a key are handled one at a time, in input order, while items with
+// different keys run in parallel.
+// If concurrency <= 1, items are processed sequentially in input order.
+// When the handler fails for an item, the remaining items with the same key are skipped,
+// so per-key ordering is never violated; other keys keep running. Failures and skipped
+// items are returned as a SliceError ordered by index, skipped items with ErrSkipped.
+// A panicking handler is reported as a *PanicError for its item; pass WithRepanic to
+// propagate the panic to the caller instead.
+func ForEachKeyed[In any, K comparable](input []In, keyFn func(item In) K, concurrency int, handler func(item In) error, opts ...Option) error {
+	if len(input) == 0 || keyFn == nil || handler == nil {
+		return nil
+	}
+	return forEachKeyed("ForEachKeyed", input, keyFn, concurrency, func(_ int, item In) error { return handler(item) }, applyOptions(opts))
+}
+
+// MapParallelKeyed maps the slice with up to concurrency workers, with the ordering
+// guarantees of ForEachKeyed: items sharing a key are mapped one at a time in input
+// order, while items with different keys are mapped in parallel.
+// The result holds the outputs of successful items in input order. As with
+// ForEachKeyed, a failure skips the remaining items with the same key, and failed and
+// skipped items are returned as a SliceError ordered by index, so every input missing
+// from the result can be identified. Returns nil if the input is empty.
+func MapParallelKeyed[In any, K comparable, Out any](input []In, keyFn func(item In) K, concurrency int, mapper func(item In) (Out, error), opts ...Option) ([]Out, error) {
+	if len(input) == 0 || keyFn == nil || mapper == nil {
+		return nil, nil
+	}
+	var (
+		outputs = make([]Out, len(input))
+		done    = make([]bool, len(input))
+	)
+	err := forEachKeyed("MapParallelKeyed", input, keyFn, concurrency, func(i int, item In) error {
+		out, err := mapper(item)
+		if err != nil {
+			return err
+		}
+		outputs[i], done[i] = out, true
+		return nil
+	}, applyOptions(opts))
+
+	var result []Out
+	for i, out := range outputs {
+		if done[i] {
+			result = append(result, out)
+		}
+	}
+	return result, err
+}
+
+// forEachKeyed implements ForEachKeyed, passing each item's index to the handler.
+// op names the operation for observers.
+func forEachKeyed[In any, K comparable](op string, input []In, keyFn func(item In) K, concurrency int, handler func(i int, item In) error, o options) error {
+	var (
+		mu         sync.Mutex
+		errs       SliceError[In]
+		firstPanic *PanicError
+		stats      OpStats
+	)
+	defer o.observe(op, len(input), &stats)()
+	// call runs the handler for the item at index i and reports whether it succeeded.
+	call := func(i int) bool {
+		err := safeCall(i, func() error { return handler(i, input[i]) })
+		if err == nil {
+			return true
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		stats.Errors++
+		if pe, ok := err.(*PanicError); ok && firstPanic == nil {
+			firstPanic = pe
+		}
+		errs = append(errs, &ElemError[In]{Index: i, Value: input[i], Err: err})
+		return false
+	}
+	// skip reports the items at indexes as skipped.
+	skip := func(indexes ...int) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, i := range indexes {
+			errs = append(errs, &ElemError[In]{Index: i, Value: input[i], Err: ErrSkipped})
+		}
+	}
+
+	keys := Map(input, keyFn)
+	if concurrency <= 1 {
+		failed := make(map[K]struct{})
+		for i, k := range keys {
+			if _, ok := failed[k]; ok {
+				skip(i)
+				continue
+			}
+			if !call(i) {
+				failed[k] = struct{}{}
+			}
+		}
+	} else {
+		// Group item indexes by key, keeping keys in order of first appearance.
+		var (
+			groups [][]int
+			byKey  = make(map[K]int)
+		)
+		for i, k := range keys {
+			g, ok := byKey[k]
+			if !ok {
+				g = len(groups)
+				byKey[k] = g
+				groups = append(groups, nil)
+			}
+			groups[g] = append(groups[g], i)
+		}
+
+		var (
+			wg  sync.WaitGroup
+			sem = make(chan struct{}, concurrency)
+		)
+		for _, indexes := range groups {
+			sem <- struct{}{} // Acquire token
+			wg.Add(1)
+			go func(indexes []int) {
+				defer wg.Done()
+				defer func() { <-sem }() // Release token
+				for n, i := range indexes {
+					if !call(i) {
+						skip(indexes[n+1:]...)
+						return
+					}
+				}
+			}(indexes)
+		}
+		wg.Wait()
+	}
+
+	if firstPanic != nil && o.repanic {
+		panic(firstPanic)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Index < errs[j].Index })
+	return errs
+}
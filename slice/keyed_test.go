@@ -0,0 +1,195 @@
+package slice_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+type event struct {
+	Account string
+	Seq     int
+}
+
+func TestForEachKeyed(t *testing.T) {
+	var input []event
+	for seq := 0; seq < 5; seq++ {
+		for _, acc := range []string{"a", "b", "c", "d"} {
+			input = append(input, event{acc, seq})
+		}
+	}
+	byAccount := func(e event) string { return e.Account }
+
+	for _, concurrency := range []int{1, 3} {
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				seen     = make(map[string][]int)
+				inFlight = make(map[string]*int32)
+			)
+			for _, acc := range []string{"a", "b", "c", "d"} {
+				inFlight[acc] = new(int32)
+			}
+			err := slice.ForEachKeyed(input, byAccount, concurrency, func(e event) error {
+				if atomic.AddInt32(inFlight[e.Account], 1) > 1 {
+					t.Errorf("account %s processed concurrently", e.Account)
+				}
+				time.Sleep(time.Millisecond)
+				atomic.AddInt32(inFlight[e.Account], -1)
+				mu.Lock()
+				seen[e.Account] = append(seen[e.Account], e.Seq)
+				mu.Unlock()
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for acc, seqs := range seen {
+				if !slicesEqual(seqs, []int{0, 1, 2, 3, 4}) {
+					t.Errorf("account %s processed out of order: %v", acc, seqs)
+				}
+			}
+		})
+	}
+}
+
+func TestForEachKeyed_Errors(t *testing.T) {
+	input := []event{{"a", 0}, {"b", 0}, {"a", 1}, {"b", 1}, {"a", 2}}
+	errFail := errors.New("fail")
+
+	for _, concurrency := range []int{1, 2} {
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			var (
+				mu        sync.Mutex
+				processed []event
+			)
+			err := slice.ForEachKeyed(input, func(e event) string { return e.Account }, concurrency, func(e event) error {
+				mu.Lock()
+				processed = append(processed, e)
+				mu.Unlock()
+				if e == (event{"a", 1}) {
+					return errFail
+				}
+				if e == (event{"b", 1}) {
+					panic("boom")
+				}
+				return nil
+			})
+
+			var sliceErr slice.SliceError[event]
+			if !errors.As(err, &sliceErr) || len(sliceErr) != 3 {
+				t.Fatalf("expected 2 failures and 1 skipped item, got %v", err)
+			}
+			if sliceErr[0].Index != 2 || sliceErr[1].Index != 3 || sliceErr[2].Index != 4 {
+				t.Errorf("expected errors at indexes 2, 3 and 4, got %d, %d and %d", sliceErr[0].Index, sliceErr[1].Index, sliceErr[2].Index)
+			}
+			if !errors.Is(sliceErr[2], slice.ErrSkipped) {
+				t.Errorf("expected index 4 to be reported as skipped, got %v", sliceErr[2])
+			}
+			var panicErr *slice.PanicError
+			if !errors.As(sliceErr[1], &panicErr) {
+				t.Errorf("expected PanicError for index 3, got %v", sliceErr[1])
+			}
+			for _, e := range processed {
+				if e == (event{"a", 2}) {
+					t.Error("expected items after a failure to be skipped for that key")
+				}
+			}
+		})
+	}
+}
+
+func TestMapParallelKeyed(t *testing.T) {
+	var input []event
+	for seq := 0; seq < 5; seq++ {
+		for _, acc := range []string{"a", "b", "c"} {
+			input = append(input, event{acc, seq})
+		}
+	}
+	byAccount := func(e event) string { return e.Account }
+
+	for _, concurrency := range []int{1, 3} {
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			var (
+				mu   sync.Mutex
+				last = map[string]int{}
+			)
+			got, err := slice.MapParallelKeyed(input, byAccount, concurrency, func(e event) (string, error) {
+				mu.Lock()
+				prev, seen := last[e.Account]
+				last[e.Account] = e.Seq
+				mu.Unlock()
+				if seen && prev != e.Seq-1 {
+					t.Errorf("account %s: seq %d mapped after %d", e.Account, e.Seq, prev)
+				}
+				time.Sleep(time.Millisecond)
+				return fmt.Sprintf("%s%d", e.Account, e.Seq), nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want := slice.Map(input, func(e event) string { return fmt.Sprintf("%s%d", e.Account, e.Seq) })
+			if !slicesEqual(got, want) {
+				t.Errorf("MapParallelKeyed() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestMapParallelKeyed_Error(t *testing.T) {
+	input := []event{{"a", 0}, {"b", 0}, {"a", 1}, {"b", 1}, {"a", 2}}
+	errBad := errors.New("bad")
+	got, err := slice.MapParallelKeyed(input, func(e event) string { return e.Account }, 2, func(e event) (int, error) {
+		if e.Account == "a" && e.Seq == 1 {
+			return 0, errBad
+		}
+		return e.Seq, nil
+	})
+	// a1 fails, so a2 is skipped; b keeps going.
+	if want := []int{0, 0, 1}; !slicesEqual(got, want) {
+		t.Errorf("MapParallelKeyed() = %v, want %v", got, want)
+	}
+	var sliceErr slice.SliceError[event]
+	if !errors.As(err, &sliceErr) || len(sliceErr) != 2 || sliceErr[0].Index != 2 || sliceErr[1].Index != 4 {
+		t.Fatalf("expected a failure at index 2 and a skipped item at index 4, got %v", err)
+	}
+	if !errors.Is(sliceErr[0], errBad) || !errors.Is(sliceErr[1], slice.ErrSkipped) {
+		t.Errorf("expected errBad then ErrSkipped, got %v", err)
+	}
+
+	for _, input := range [][]event{nil, {}} {
+		if got, err := slice.MapParallelKeyed(input, func(e event) string { return e.Account }, 2, func(e event) (int, error) { return e.Seq, nil }); got != nil || err != nil {
+			t.Errorf("MapParallelKeyed(%v) = %v, %v, want nil, nil", input, got, err)
+		}
+	}
+}
+
+func TestKeyed_Observer(t *testing.T) {
+	input := []event{{"a", 0}, {"b", 0}, {"a", 1}}
+	byAccount := func(e event) string { return e.Account }
+
+	var stats []slice.OpStats
+	obs := slice.WithObserver(slice.ObserverFunc(func(s slice.OpStats) { stats = append(stats, s) }))
+	_ = slice.ForEachKeyed(input, byAccount, 2, func(e event) error {
+		if e.Account == "b" {
+			return errors.New("fail")
+		}
+		return nil
+	}, obs)
+	_, _ = slice.MapParallelKeyed(input, byAccount, 1, func(e event) (int, error) { return e.Seq, nil }, obs)
+
+	if len(stats) != 2 {
+		t.Fatalf("expected 2 observed operations, got %v", stats)
+	}
+	if stats[0].Op != "ForEachKeyed" || stats[0].Elements != 3 || stats[0].Errors != 1 {
+		t.Errorf("unexpected ForEachKeyed stats %+v", stats[0])
+	}
+	if stats[1].Op != "MapParallelKeyed" || stats[1].Elements != 3 || stats[1].Errors != 0 {
+		t.Errorf("unexpected MapParallelKeyed stats %+v", stats[1])
+	}
+}
@@ -0,0 +1,46 @@
+package slice
+
+// MapChunked splits the slice into chunks of chunkSize and maps each chunk with up to
+// concurrency mappers running at the same time, e.g. for batched API calls that return
+// batched results. The outputs of all chunks are concatenated in chunk order.
+// If concurrency <= 1, chunks are mapped sequentially.
+// Outputs of failing chunks are left out, and their errors are returned as a
+// SliceError whose elements carry the chunk index and the chunk itself. A panicking
+// mapper is reported as a *PanicError for its chunk; pass WithRepanic to propagate
+// the panic to the caller instead. Returns nil if the input is empty.
+func MapChunked[In, Out any](input []In, chunkSize int, concurrency int, mapper func(chunk []In) ([]Out, error), opts ...Option) ([]Out, error) {
+	if len(input) == 0 || mapper == nil {
+		return nil, nil
+	}
+	o := applyOptions(opts)
+	var stats OpStats
+	defer o.observe("MapChunked", len(input), &stats)()
+
+	var (
+		chunks  = Chunk(input, chunkSize)
+		outputs = make([][]Out, len(chunks))
+		failed  = make([]error, len(chunks))
+	)
+	parallel(len(chunks), concurrency, func(i int) {
+		failed[i] = safeCall(i, func() error {
+			var err error
+			outputs[i], err = mapper(chunks[i])
+			return err
+		})
+	})
+	stats.Chunks = len(chunks)
+
+	var errs SliceError[[]In]
+	for i, err := range failed {
+		if err != nil {
+			stats.Errors++
+			outputs[i] = nil
+			errs = append(errs, &ElemError[[]In]{Index: i, Value: chunks[i], Err: o.handleError(err)})
+		}
+	}
+	result := Flatten(outputs)
+	if len(errs) == 0 {
+		return result, nil
+	}
+	return result, errs
+}
@@ -0,0 +1,71 @@
+package slice_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestMapChunked(t *testing.T) {
+	input := make([]int, 23)
+	for i := range input {
+		input[i] = i
+	}
+	want := slice.Map(input, func(i int) string { return fmt.Sprint(i * 2) })
+
+	for _, concurrency := range []int{1, 4} {
+		t.Run(fmt.Sprintf("concurrency %d", concurrency), func(t *testing.T) {
+			got, err := slice.MapChunked(input, 5, concurrency, func(chunk []int) ([]string, error) {
+				if len(chunk) > 5 {
+					t.Errorf("chunk of %d exceeds chunk size", len(chunk))
+				}
+				return slice.Map(chunk, func(i int) string { return fmt.Sprint(i * 2) }), nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slicesEqual(got, want) {
+				t.Errorf("MapChunked() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestMapChunked_Empty(t *testing.T) {
+	mapper := func(chunk []int) ([]int, error) { return chunk, nil }
+	for _, input := range [][]int{nil, {}} {
+		if got, err := slice.MapChunked(input, 2, 2, mapper); got != nil || err != nil {
+			t.Errorf("MapChunked(%v) = %v, %v, want nil, nil", input, got, err)
+		}
+	}
+}
+
+func TestMapChunked_Errors(t *testing.T) {
+	errBatch := errors.New("batch rejected")
+	got, err := slice.MapChunked([]int{1, 2, 3, 4, 5, 6}, 2, 3, func(chunk []int) ([]int, error) {
+		switch chunk[0] {
+		case 3:
+			return nil, errBatch
+		case 5:
+			panic("boom")
+		}
+		return chunk, nil
+	})
+	if !slicesEqual(got, []int{1, 2}) {
+		t.Errorf("MapChunked() = %v, want [1 2]", got)
+	}
+
+	var sliceErr slice.SliceError[[]int]
+	if !errors.As(err, &sliceErr) || len(sliceErr) != 2 {
+		t.Fatalf("expected two chunk errors, got %v", err)
+	}
+	if sliceErr[0].Index != 1 || !errors.Is(sliceErr[0], errBatch) {
+		t.Errorf("expected chunk 1 to fail with errBatch, got %v", sliceErr[0])
+	}
+	var panicErr *slice.PanicError
+	if sliceErr[1].Index != 2 || !errors.As(sliceErr[1].Err, &panicErr) {
+		t.Errorf("expected chunk 2 to report a PanicError, got %v", sliceErr[1])
+	}
+}
@@ -0,0 +1,69 @@
+package slice
+
+import "sync"
+
+// MapReduce maps every element of the slice with up to concurrency mappers running at
+// the same time, then reduces the mapped values serially in input order, so the result
+// is deterministic regardless of scheduling.
+// If concurrency <= 1, elements are mapped sequentially.
+// Elements whose mapper fails are left out of the reduction, and their errors are
+// returned as a SliceError. A panicking mapper is reported as a *PanicError for its
+// element; pass WithRepanic to propagate the panic to the caller instead.
+func MapReduce[In, M, Acc any](input []In, concurrency int, mapper func(item In) (M, error), reducer func(acc Acc, mapped M) Acc, initial Acc, opts ...Option) (Acc, error) {
+	if len(input) == 0 || mapper == nil || reducer == nil {
+		return initial, nil
+	}
+	o := applyOptions(opts)
+	var stats OpStats
+	defer o.observe("MapReduce", len(input), &stats)()
+
+	var (
+		mapped = make([]M, len(input))
+		failed = make([]error, len(input))
+	)
+	mapAt := func(i int) {
+		failed[i] = safeCall(i, func() error {
+			var err error
+			mapped[i], err = mapper(input[i])
+			return err
+		})
+	}
+
+	if concurrency <= 1 {
+		for i := range input {
+			mapAt(i)
+		}
+	} else {
+		var (
+			wg  sync.WaitGroup
+			sem = make(chan struct{}, concurrency)
+		)
+		for i := range input {
+			sem <- struct{}{} // Acquire token
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				defer func() { <-sem }() // Release token
+				mapAt(i)
+			}(i)
+		}
+		wg.Wait()
+	}
+
+	var (
+		acc  = initial
+		errs SliceError[In]
+	)
+	for i, err := range failed {
+		if err != nil {
+			stats.Errors++
+			errs = append(errs, &ElemError[In]{Index: i, Value: input[i], Err: o.handleError(err)})
+			continue
+		}
+		acc = reducer(acc, mapped[i])
+	}
+	if len(errs) == 0 {
+		return acc, nil
+	}
+	return acc, errs
+}
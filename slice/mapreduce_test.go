@@ -0,0 +1,63 @@
+package slice_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestMapReduce(t *testing.T) {
+	input := []int{5, 4, 3, 2, 1}
+	mapper := func(item int) (string, error) {
+		time.Sleep(time.Duration(item) * time.Millisecond) // finish out of order
+		return strconv.Itoa(item), nil
+	}
+	concat := func(acc string, s string) string { return acc + s }
+
+	for _, concurrency := range []int{1, 3} {
+		got, err := slice.MapReduce(input, concurrency, mapper, concat, ">")
+		if err != nil {
+			t.Errorf("concurrency %d: unexpected error: %v", concurrency, err)
+		}
+		if got != ">54321" {
+			t.Errorf("concurrency %d: expected >54321, got %q", concurrency, got)
+		}
+	}
+
+	got, err := slice.MapReduce(nil, 2, mapper, concat, "init")
+	if got != "init" || err != nil {
+		t.Errorf("expected initial value for empty input, got %q, %v", got, err)
+	}
+}
+
+func TestMapReduce_Errors(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	errOdd := errors.New("odd")
+	sum, err := slice.MapReduce(input, 2, func(item int) (int, error) {
+		if item%2 != 0 {
+			return 0, errOdd
+		}
+		if item == 4 {
+			panic("boom")
+		}
+		return item, nil
+	}, func(acc, v int) int { return acc + v }, 0)
+
+	if sum != 2 {
+		t.Errorf("expected sum of successful elements 2, got %d", sum)
+	}
+	var sliceErr slice.SliceError[int]
+	if !errors.As(err, &sliceErr) || len(sliceErr) != 3 {
+		t.Fatalf("expected 3 element errors, got %v", err)
+	}
+	if sliceErr[0].Index != 0 || sliceErr[1].Index != 2 || sliceErr[2].Index != 3 {
+		t.Errorf("expected errors in input order, got %v", sliceErr)
+	}
+	var panicErr *slice.PanicError
+	if !errors.As(sliceErr[2], &panicErr) {
+		t.Errorf("expected panic to be reported as PanicError, got %v", sliceErr[2])
+	}
+}
@@ -0,0 +1,113 @@
+package slice
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// MapStream maps every element of the slice with up to concurrency mappers running at
+// the same time, and returns an iterator that yields the results in input order as soon
+// as each one and all before it are ready.
+// If concurrency <= 1, elements are mapped lazily, one per iteration step.
+// A failed element is yielded with the zero value and an *ElemError; iteration continues
+// unless the consumer stops. A panicking mapper is reported as a *PanicError inside the
+// *ElemError; pass WithRepanic to propagate the panic to the consumer instead.
+// If ctx is cancelled, the iterator yields ctx.Err() once and stops. Stopping early
+// cancels the context passed to in-flight mappers and waits for them to return.
+func MapStream[In, Out any](ctx context.Context, input []In, concurrency int, mapper func(ctx context.Context, item In) (Out, error), opts ...Option) iter.Seq2[Out, error] {
+	return func(yield func(Out, error) bool) {
+		if len(input) == 0 || mapper == nil {
+			return
+		}
+		o := applyOptions(opts)
+		var stats OpStats
+		defer o.observe("MapStream", len(input), &stats)()
+
+		var zero Out
+		mapAt := func(ctx context.Context, i int) (out Out, err error) {
+			err = safeCall(i, func() error {
+				var err error
+				out, err = mapper(ctx, input[i])
+				return err
+			})
+			if err != nil {
+				return zero, &ElemError[In]{Index: i, Value: input[i], Err: err}
+			}
+			return out, nil
+		}
+		emit := func(out Out, err error) bool {
+			if elemErr, ok := err.(*ElemError[In]); ok {
+				stats.Errors++
+				elemErr.Err = o.handleError(elemErr.Err)
+			}
+			return yield(out, err)
+		}
+
+		if concurrency <= 1 {
+			for i := range input {
+				if err := ctx.Err(); err != nil {
+					yield(zero, err)
+					return
+				}
+				if !emit(mapAt(ctx, i)) {
+					return
+				}
+			}
+			return
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		defer wg.Wait()
+		defer cancel()
+
+		type result struct {
+			out Out
+			err error
+		}
+		var (
+			results = make([]result, len(input))
+			done    = make([]chan struct{}, len(input))
+			sem     = make(chan struct{}, concurrency)
+		)
+		for i := range done {
+			done[i] = make(chan struct{})
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range input {
+				select {
+				case sem <- struct{}{}: // Acquire token
+				case <-ctx.Done():
+					return
+				}
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					defer func() { <-sem }() // Release token
+					results[i].out, results[i].err = mapAt(ctx, i)
+					close(done[i])
+				}(i)
+			}
+		}()
+
+		for i := range input {
+			select {
+			case <-done[i]:
+			default:
+				select {
+				case <-done[i]:
+				case <-ctx.Done():
+					yield(zero, ctx.Err())
+					return
+				}
+			}
+			if !emit(results[i].out, results[i].err) {
+				return
+			}
+		}
+	}
+}
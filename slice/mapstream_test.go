@@ -0,0 +1,147 @@
+package slice_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestMapStream(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6}
+	square := func(_ context.Context, v int) (int, error) {
+		// Later elements finish first.
+		time.Sleep(time.Duration(len(input)-v) * time.Millisecond)
+		return v * v, nil
+	}
+
+	for _, concurrency := range []int{1, 3} {
+		var got []int
+		for v, err := range slice.MapStream(context.Background(), input, concurrency, square) {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got = append(got, v)
+		}
+		if !slicesEqual(got, []int{1, 4, 9, 16, 25, 36}) {
+			t.Errorf("concurrency %d: MapStream() = %v", concurrency, got)
+		}
+	}
+}
+
+func TestMapStream_StreamsPrefix(t *testing.T) {
+	release := make(chan struct{})
+	mapper := func(ctx context.Context, v int) (int, error) {
+		if v == 2 {
+			select {
+			case <-release:
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			}
+		}
+		return v, nil
+	}
+
+	for v, err := range slice.MapStream(context.Background(), []int{1, 2, 3}, 2, mapper) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// The first result arrives while the second is still blocked.
+		if v == 1 {
+			close(release)
+		}
+	}
+}
+
+func TestMapStream_Errors(t *testing.T) {
+	errOdd := errors.New("odd")
+	mapper := func(_ context.Context, v int) (string, error) {
+		if v == 4 {
+			panic("boom")
+		}
+		if v%2 == 1 {
+			return "", errOdd
+		}
+		return "ok", nil
+	}
+
+	var failed []int
+	for _, err := range slice.MapStream(context.Background(), []int{1, 2, 3, 4}, 2, mapper) {
+		var elemErr *slice.ElemError[int]
+		if errors.As(err, &elemErr) {
+			failed = append(failed, elemErr.Index)
+		}
+	}
+	if !slicesEqual(failed, []int{0, 2, 3}) {
+		t.Errorf("failed indexes = %v, want [0 2 3]", failed)
+	}
+
+	defer func() {
+		if _, ok := recover().(*slice.PanicError); !ok {
+			t.Error("expected *PanicError to be re-panicked")
+		}
+	}()
+	for range slice.MapStream(context.Background(), []int{4}, 2, mapper, slice.WithRepanic()) {
+	}
+}
+
+func TestMapStream_EarlyStop(t *testing.T) {
+	var started atomic.Int32
+	var cancelled atomic.Int32
+	mapper := func(ctx context.Context, v int) (int, error) {
+		started.Add(1)
+		if v == 0 {
+			return v, nil
+		}
+		<-ctx.Done()
+		cancelled.Add(1)
+		return 0, ctx.Err()
+	}
+
+	input := make([]int, 100)
+	for i := range input {
+		input[i] = i
+	}
+	for range slice.MapStream(context.Background(), input, 4, mapper) {
+		break
+	}
+	// All in-flight mappers have returned by the time the loop exits.
+	if started.Load() == int32(len(input)) || started.Load()-1 != cancelled.Load() {
+		t.Errorf("started %d mappers, %d cancelled", started.Load(), cancelled.Load())
+	}
+}
+
+func TestMapStream_ContextCancelled(t *testing.T) {
+	for _, concurrency := range []int{1, 2} {
+		ctx, cancel := context.WithCancel(context.Background())
+		mapper := func(ctx context.Context, v int) (int, error) {
+			if v == 1 {
+				return v, nil
+			}
+			cancel()
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}
+
+		var got []int
+		var lastErr error
+		for v, err := range slice.MapStream(ctx, []int{1, 2, 3, 4}, concurrency, mapper) {
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			got = append(got, v)
+		}
+		cancel()
+
+		if !errors.Is(lastErr, context.Canceled) {
+			t.Errorf("concurrency %d: expected context.Canceled, got %v", concurrency, lastErr)
+		}
+		if len(got) > 1 || (len(got) == 1 && got[0] != 1) {
+			t.Errorf("concurrency %d: got %v", concurrency, got)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package slice
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+)
+
+// SortChunked returns a sorted copy of the slice. The input is split into chunks of
+// chunkSize that are sorted with up to concurrency goroutines, then merged pairwise,
+// also in parallel. The sort is stable. The input slice is not modified.
+// If concurrency <= 1, chunks are sorted and merged sequentially.
+// If chunkSize <= 0, the input is split evenly across concurrency chunks.
+// Returns nil if the input slice is nil.
+// If less panics, or is nil, SortChunked panics on the calling goroutine with a
+// *PanicError once all running sorts and merges have stopped.
+func SortChunked[In any](input []In, less func(a, b In) bool, chunkSize int, concurrency int) []In {
+	if input == nil {
+		return nil
+	}
+	result := make([]In, len(input))
+	copy(result, input)
+	if len(result) < 2 {
+		return result
+	}
+	if chunkSize <= 0 {
+		chunkSize = (len(result) + max(concurrency, 1) - 1) / max(concurrency, 1)
+	}
+	compare := func(a, b In) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	runs := Chunk(result, chunkSize)
+	parallel(len(runs), concurrency, func(i int) {
+		slices.SortStableFunc(runs[i], compare)
+	})
+
+	// Merge adjacent runs until one remains. Adjacent pairs keep the merge stable.
+	for len(runs) > 1 {
+		merged := make([][]In, (len(runs)+1)/2)
+		parallel(len(merged), concurrency, func(i int) {
+			if 2*i+1 == len(runs) {
+				merged[i] = runs[2*i]
+				return
+			}
+			merged[i] = mergeTwo(runs[2*i], runs[2*i+1], less)
+		})
+		runs = merged
+	}
+	return runs[0]
+}
+
+// MergeSorted merges slices that are each sorted in ascending order into a single
+// sorted slice. Equal elements keep the order of the slices they came from.
+func MergeSorted[In cmp.Ordered](sorted ...[]In) []In {
+	return MergeSortedFunc(cmp.Less[In], sorted...)
+}
+
+// MergeSortedFunc merges slices that are each sorted according to less into a single
+// sorted slice. Equal elements keep the order of the slices they came from.
+func MergeSortedFunc[In any](less func(a, b In) bool, sorted ...[]In) []In {
+	total := 0
+	for _, s := range sorted {
+		total += len(s)
+	}
+	if total == 0 {
+		return nil
+	}
+	runs := sorted
+	for len(runs) > 1 {
+		merged := make([][]In, 0, (len(runs)+1)/2)
+		for i := 0; i < len(runs); i += 2 {
+			if i+1 == len(runs) {
+				merged = append(merged, runs[i])
+				continue
+			}
+			merged = append(merged, mergeTwo(runs[i], runs[i+1], less))
+		}
+		runs = merged
+	}
+	result := make([]In, total)
+	copy(result, runs[0])
+	return result
+}
+
+// mergeTwo merges two sorted slices into a new slice, taking from a on ties.
+func mergeTwo[In any](a, b []In, less func(a, b In) bool) []In {
+	result := make([]In, 0, len(a)+len(b))
+	for len(a) > 0 && len(b) > 0 {
+		if less(b[0], a[0]) {
+			result, b = append(result, b[0]), b[1:]
+		} else {
+			result, a = append(result, a[0]), a[1:]
+		}
+	}
+	result = append(result, a...)
+	return append(result, b...)
+}
+
+// parallel calls fn for every index in [0, n) with up to concurrency calls at a time.
+// If concurrency <= 1, calls are made sequentially. A panic in fn is recovered in its
+// goroutine, and once all calls have returned, the first one is re-raised on the
+// calling goroutine as a *PanicError.
+func parallel(n, concurrency int, fn func(i int)) {
+	call := func(i int) error {
+		return safeCall(i, func() error {
+			fn(i)
+			return nil
+		})
+	}
+	if concurrency <= 1 {
+		for i := range n {
+			if err := call(i); err != nil {
+				panic(err)
+			}
+		}
+		return
+	}
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		panicErr error
+		sem      = make(chan struct{}, concurrency)
+	)
+	for i := range n {
+		sem <- struct{}{} // Acquire token
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }() // Release token
+			if err := call(i); err != nil {
+				once.Do(func() { panicErr = err })
+			}
+		}(i)
+	}
+	wg.Wait()
+	if panicErr != nil {
+		panic(panicErr)
+	}
+}
@@ -0,0 +1,112 @@
+package slice_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestSortChunked(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	input := make([]int, 1000)
+	for i := range input {
+		input[i] = r.IntN(100)
+	}
+	want := slices.Clone(input)
+	slices.Sort(want)
+	less := func(a, b int) bool { return a < b }
+
+	tests := []struct {
+		name        string
+		chunkSize   int
+		concurrency int
+	}{
+		{name: "sequential", chunkSize: 64, concurrency: 1},
+		{name: "parallel", chunkSize: 64, concurrency: 4},
+		{name: "odd chunk count", chunkSize: 333, concurrency: 2},
+		{name: "auto chunk size", chunkSize: 0, concurrency: 3},
+		{name: "single chunk", chunkSize: 5000, concurrency: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := slices.Clone(input)
+			got := slice.SortChunked(input, less, tt.chunkSize, tt.concurrency)
+			if !slicesEqual(got, want) {
+				t.Errorf("SortChunked() is not sorted")
+			}
+			if !slicesEqual(input, before) {
+				t.Errorf("SortChunked() modified the input")
+			}
+		})
+	}
+
+	if got := slice.SortChunked[int](nil, less, 10, 2); got != nil {
+		t.Errorf("SortChunked(nil) = %v, want nil", got)
+	}
+}
+
+func TestSortChunked_Stable(t *testing.T) {
+	input := []user{{ID: 2, Name: "a"}, {ID: 1, Name: "b"}, {ID: 2, Name: "c"}, {ID: 1, Name: "d"}, {ID: 2, Name: "e"}}
+	got := slice.SortChunked(input, func(a, b user) bool { return a.ID < b.ID }, 2, 2)
+	names := slice.Pluck(got, func(u user) string { return u.Name })
+	if !slicesEqual(names, []string{"b", "d", "a", "c", "e"}) {
+		t.Errorf("SortChunked() = %v, want stable order", names)
+	}
+}
+
+func TestSortChunked_Panic(t *testing.T) {
+	input := []int{5, 3, 8, 1, 9, 2, 7, 4}
+	tests := []struct {
+		name        string
+		less        func(a, b int) bool
+		concurrency int
+	}{
+		{name: "panicking less", less: func(a, b int) bool { panic("boom") }, concurrency: 4},
+		{name: "nil less", less: nil, concurrency: 4},
+		{name: "sequential", less: func(a, b int) bool { panic("boom") }, concurrency: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				var panicErr *slice.PanicError
+				if err, ok := recover().(error); !ok || !errors.As(err, &panicErr) {
+					t.Errorf("expected a PanicError on the calling goroutine, got %v", err)
+				}
+			}()
+			slice.SortChunked(input, tt.less, 2, tt.concurrency)
+		})
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	got := slice.MergeSorted([]int{1, 4, 9}, []int{2, 3}, nil, []int{0, 10})
+	if !slicesEqual(got, []int{0, 1, 2, 3, 4, 9, 10}) {
+		t.Errorf("MergeSorted() = %v", got)
+	}
+	if got := slice.MergeSorted[int](); got != nil {
+		t.Errorf("MergeSorted() = %v, want nil", got)
+	}
+
+	a := []int{1, 2}
+	got = slice.MergeSorted(a)
+	got[0] = 99
+	if a[0] != 1 {
+		t.Error("MergeSorted() result shares memory with its input")
+	}
+}
+
+func TestMergeSortedFunc(t *testing.T) {
+	byID := func(a, b user) bool { return a.ID < b.ID }
+	got := slice.MergeSortedFunc(byID,
+		[]user{{ID: 1, Name: "x1"}, {ID: 3, Name: "x3"}},
+		[]user{{ID: 1, Name: "y1"}, {ID: 2, Name: "y2"}},
+	)
+	names := slice.Pluck(got, func(u user) string { return u.Name })
+	if !slicesEqual(names, []string{"x1", "y1", "y2", "x3"}) {
+		t.Errorf("MergeSortedFunc() = %v", names)
+	}
+}
@@ -0,0 +1,41 @@
+package slice
+
+import "cmp"
+
+// MinMax returns the smallest and largest elements of the slice in a single pass.
+// ok is false if the slice is empty.
+func MinMax[In cmp.Ordered](input []In) (minVal, maxVal In, ok bool) {
+	if len(input) == 0 {
+		return minVal, maxVal, false
+	}
+	minVal, maxVal = input[0], input[0]
+	for _, item := range input[1:] {
+		if item < minVal {
+			minVal = item
+		} else if item > maxVal {
+			maxVal = item
+		}
+	}
+	return minVal, maxVal, true
+}
+
+// MinMaxBy returns the elements with the smallest and largest keys in a single pass,
+// calling keyFn once per element. When several elements share an extreme key, the
+// first one is returned. ok is false if the slice is empty.
+func MinMaxBy[In any, K cmp.Ordered](input []In, keyFn func(item In) K) (minItem, maxItem In, ok bool) {
+	if len(input) == 0 || keyFn == nil {
+		return minItem, maxItem, false
+	}
+	minItem, maxItem = input[0], input[0]
+	minKey := keyFn(input[0])
+	maxKey := minKey
+	for _, item := range input[1:] {
+		k := keyFn(item)
+		if k < minKey {
+			minItem, minKey = item, k
+		} else if k > maxKey {
+			maxItem, maxKey = item, k
+		}
+	}
+	return minItem, maxItem, true
+}
@@ -0,0 +1,32 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestMinMax(t *testing.T) {
+	lo, hi, ok := slice.MinMax([]int{3, -1, 7, 2})
+	if !ok || lo != -1 || hi != 7 {
+		t.Errorf("MinMax() = (%d, %d, %v), want (-1, 7, true)", lo, hi, ok)
+	}
+	lo, hi, ok = slice.MinMax([]int{5})
+	if !ok || lo != 5 || hi != 5 {
+		t.Errorf("MinMax() = (%d, %d, %v), want (5, 5, true)", lo, hi, ok)
+	}
+	if _, _, ok := slice.MinMax[float64](nil); ok {
+		t.Error("MinMax() ok = true for empty input")
+	}
+}
+
+func TestMinMaxBy(t *testing.T) {
+	input := []user{{3, "c"}, {1, "a"}, {9, "z"}, {1, "a2"}, {9, "z2"}}
+	lo, hi, ok := slice.MinMaxBy(input, func(u user) int { return u.ID })
+	if !ok || lo != (user{1, "a"}) || hi != (user{9, "z"}) {
+		t.Errorf("MinMaxBy() = (%v, %v, %v)", lo, hi, ok)
+	}
+	if _, _, ok := slice.MinMaxBy[user, int](nil, nil); ok {
+		t.Error("MinMaxBy() ok = true for empty input")
+	}
+}
@@ -0,0 +1,61 @@
+package slice
+
+import "time"
+
+// OpStats describes a finished slice operation.
+type OpStats struct {
+	// Op is the name of the operation, such as "Collect" or "ForEachChunk".
+	Op string
+	// Elements is the number of input elements.
+	Elements int
+	// Chunks is the number of chunks handled, for chunked operations.
+	Chunks int
+	// Errors is the number of failed elements or chunks.
+	Errors int
+	// Duration is the wall time of the operation.
+	Duration time.Duration
+}
+
+// Observer receives instrumentation events from slice operations, for exporting
+// metrics or tracing spans. Implementations must be safe for concurrent use if
+// they are shared between operations running at the same time.
+type Observer interface {
+	// OnStart is called when an operation begins.
+	OnStart(op string, elements int)
+	// OnFinish is called when an operation ends, including when it panics.
+	OnFinish(stats OpStats)
+}
+
+// ObserverFunc adapts a function to an Observer that is only told about finished operations.
+type ObserverFunc func(stats OpStats)
+
+// OnStart implements Observer.
+func (f ObserverFunc) OnStart(string, int) {}
+
+// OnFinish implements Observer.
+func (f ObserverFunc) OnFinish(stats OpStats) { f(stats) }
+
+// WithObserver reports the start and end of the operation to obs.
+// It is supported by Collect, CollectConcurrent, ForEachChunk, Spool.ForEachChunk,
+// ForEachKeyed, MapChunked, MapParallelKeyed, MapReduce and MapStream.
+func WithObserver(obs Observer) Option {
+	return func(o *options) {
+		o.observer = obs
+	}
+}
+
+// observe reports the start of op and returns a function that reports its end.
+// stats is read when the returned function is called, so callers can fill it in
+// as the operation progresses.
+func (o options) observe(op string, elements int, stats *OpStats) func() {
+	if o.observer == nil {
+		return func() {}
+	}
+	start := time.Now()
+	stats.Op, stats.Elements = op, elements
+	o.observer.OnStart(op, elements)
+	return func() {
+		stats.Duration = time.Since(start)
+		o.observer.OnFinish(*stats)
+	}
+}
@@ -0,0 +1,128 @@
+package slice_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+type recordingObserver struct {
+	mu       sync.Mutex
+	started  []string
+	finished []slice.OpStats
+}
+
+func (r *recordingObserver) OnStart(op string, elements int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.started = append(r.started, op)
+}
+
+func (r *recordingObserver) OnFinish(stats slice.OpStats) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.finished = append(r.finished, stats)
+}
+
+func TestWithObserver_Collect(t *testing.T) {
+	obs := &recordingObserver{}
+	_, _ = slice.Collect([]int{1, 2, 3, 4}, func(c slice.CollectorContext[int, int]) {
+		_, v := c.CurrentElem()
+		if v%2 == 0 {
+			c.Continue(errors.New("even"))
+		}
+		c.SetValue(v)
+	}, slice.WithObserver(obs))
+
+	if len(obs.started) != 1 || obs.started[0] != "Collect" {
+		t.Fatalf("started = %v", obs.started)
+	}
+	got := obs.finished[0]
+	if got.Op != "Collect" || got.Elements != 4 || got.Errors != 2 || got.Duration <= 0 {
+		t.Errorf("stats = %+v", got)
+	}
+}
+
+func TestWithObserver_ForEachChunk(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5}
+	fail := func(chunk []int) error {
+		if chunk[0] == 3 {
+			return errors.New("bad chunk")
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name        string
+		concurrency int
+		opts        []slice.Option
+		wantChunks  int
+	}{
+		{name: "sequential stops at error", concurrency: 1, wantChunks: 2},
+		{name: "concurrent", concurrency: 2, wantChunks: 3},
+		{name: "adaptive", concurrency: 1, opts: []slice.Option{slice.WithSizer(slice.LatencySizer(0, 2, 2))}, wantChunks: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stats slice.OpStats
+			opts := append(tt.opts, slice.WithObserver(slice.ObserverFunc(func(s slice.OpStats) { stats = s })))
+			_ = slice.ForEachChunk(input, 2, tt.concurrency, fail, opts...)
+			if stats.Op != "ForEachChunk" || stats.Elements != 5 || stats.Chunks != tt.wantChunks || stats.Errors != 1 {
+				t.Errorf("stats = %+v", stats)
+			}
+		})
+	}
+}
+
+func TestWithObserver_Others(t *testing.T) {
+	obs := &recordingObserver{}
+	opt := slice.WithObserver(obs)
+	fail := func(v int) (int, error) {
+		if v == 2 {
+			return 0, errors.New("two")
+		}
+		return v, nil
+	}
+
+	_, _ = slice.MapReduce([]int{1, 2, 3}, 2, fail, func(acc, v int) int { return acc + v }, 0, opt)
+	_, _ = slice.CollectConcurrent([]int{1, 2}, func(c slice.ConcurrentCollectorContext[int, int]) {
+		c.Go(func() error { return errors.New("boom") })
+	}, opt)
+	for range slice.MapStream(context.Background(), []int{1, 2, 3, 4}, 2, func(_ context.Context, v int) (int, error) {
+		return fail(v)
+	}, opt) {
+	}
+
+	want := []slice.OpStats{
+		{Op: "MapReduce", Elements: 3, Errors: 1},
+		{Op: "CollectConcurrent", Elements: 2, Errors: 2},
+		{Op: "MapStream", Elements: 4, Errors: 1},
+	}
+	if len(obs.finished) != len(want) {
+		t.Fatalf("finished = %+v", obs.finished)
+	}
+	for i, w := range want {
+		got := obs.finished[i]
+		got.Duration = 0
+		if got != w {
+			t.Errorf("stats[%d] = %+v, want %+v", i, got, w)
+		}
+	}
+}
+
+func TestWithObserver_Panic(t *testing.T) {
+	var stats slice.OpStats
+	func() {
+		defer func() { _ = recover() }()
+		_ = slice.ForEachChunk([]int{1}, 1, 1, func([]int) error { panic("boom") },
+			slice.WithRepanic(),
+			slice.WithObserver(slice.ObserverFunc(func(s slice.OpStats) { stats = s })))
+	}()
+	if stats.Op != "ForEachChunk" || stats.Errors != 1 {
+		t.Errorf("expected OnFinish after panic, got %+v", stats)
+	}
+}
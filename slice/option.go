@@ -0,0 +1,55 @@
+package slice
+
+import "time"
+
+// Option configures the behaviour of helpers such as ForEachChunk and Collect.
+type Option func(*options)
+
+// options holds the settings configured by Option values.
+type options struct {
+	repanic  bool
+	sizer    Sizer
+	observer Observer
+}
+
+// WithRepanic makes handler panics propagate to the caller instead of being returned
+// as a *PanicError. The panic is raised from the calling goroutine, after all started
+// handlers have finished, with the *PanicError as its value.
+func WithRepanic() Option {
+	return func(o *options) {
+		o.repanic = true
+	}
+}
+
+// WithSizer makes ForEachChunk size chunks adaptively: chunkSize is used for the first
+// chunk, and each later chunk is sized by s from how long the previous chunk took.
+func WithSizer(s Sizer) Option {
+	return func(o *options) {
+		o.sizer = s
+	}
+}
+
+// WithLatencyTarget is shorthand for WithSizer(LatencySizer(target, 1, 0)).
+func WithLatencyTarget(target time.Duration) Option {
+	return WithSizer(LatencySizer(target, 1, 0))
+}
+
+// applyOptions builds the settings described by opts.
+func applyOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	return o
+}
+
+// handleError re-panics err if it is a *PanicError and WithRepanic was set,
+// and returns it otherwise.
+func (o options) handleError(err error) error {
+	if pe, ok := err.(*PanicError); ok && o.repanic {
+		panic(pe)
+	}
+	return err
+}
@@ -0,0 +1,60 @@
+package slice
+
+// PadRight returns a new slice of at least length elements, appending fill after the
+// input elements as needed. Longer inputs are copied unchanged.
+func PadRight[In any](input []In, length int, fill In) []In {
+	result := make([]In, max(len(input), length))
+	n := copy(result, input)
+	for i := n; i < len(result); i++ {
+		result[i] = fill
+	}
+	return result
+}
+
+// PadLeft returns a new slice of at least length elements, inserting fill before the
+// input elements as needed. Longer inputs are copied unchanged.
+func PadLeft[In any](input []In, length int, fill In) []In {
+	result := make([]In, max(len(input), length))
+	offset := len(result) - len(input)
+	for i := 0; i < offset; i++ {
+		result[i] = fill
+	}
+	copy(result[offset:], input)
+	return result
+}
+
+// TruncateTo returns the first length elements of the slice, or the whole slice if it
+// is shorter. A negative length is treated as 0.
+// The result shares memory with the input but has its capacity capped, so appending
+// to it never overwrites the input.
+func TruncateTo[In any](input []In, length int) []In {
+	length = max(length, 0)
+	if len(input) <= length {
+		return input
+	}
+	return input[:length:length]
+}
+
+// TakeLast returns the last n elements of the slice, or the whole slice if it is shorter.
+// A negative n is treated as 0.
+// The result shares memory with the input but has its capacity capped.
+func TakeLast[In any](input []In, n int) []In {
+	n = max(n, 0)
+	if len(input) <= n {
+		return input
+	}
+	return input[len(input)-n : len(input) : len(input)]
+}
+
+// DropLast returns the slice without its last n elements, or an empty slice if it is shorter.
+// A negative n is treated as 0.
+// The result shares memory with the input but has its capacity capped, so appending
+// to it never overwrites the input.
+func DropLast[In any](input []In, n int) []In {
+	n = max(n, 0)
+	if len(input) <= n {
+		return input[:0:0]
+	}
+	end := len(input) - n
+	return input[:end:end]
+}
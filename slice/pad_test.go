@@ -0,0 +1,109 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestPadRight(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []int
+		length int
+		want   []int
+	}{
+		{name: "nil input", input: nil, length: 2, want: []int{0, 0}},
+		{name: "pad", input: []int{1, 2}, length: 4, want: []int{1, 2, 0, 0}},
+		{name: "exact", input: []int{1, 2}, length: 2, want: []int{1, 2}},
+		{name: "longer", input: []int{1, 2, 3}, length: 2, want: []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slice.PadRight(tt.input, tt.length, 0)
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("PadRight() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPadLeft(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []string
+		length int
+		want   []string
+	}{
+		{name: "nil input", input: nil, length: 1, want: []string{"-"}},
+		{name: "pad", input: []string{"a"}, length: 3, want: []string{"-", "-", "a"}},
+		{name: "longer", input: []string{"a", "b"}, length: 1, want: []string{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slice.PadLeft(tt.input, tt.length, "-")
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("PadLeft() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncateTo(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+
+	if got := slice.TruncateTo(input, 2); !slicesEqual(got, []int{1, 2}) {
+		t.Errorf("TruncateTo() = %v, want [1 2]", got)
+	}
+	if got := slice.TruncateTo(input, 10); !slicesEqual(got, input) {
+		t.Errorf("TruncateTo() = %v, want %v", got, input)
+	}
+	if got := slice.TruncateTo(input, -1); len(got) != 0 {
+		t.Errorf("TruncateTo() = %v, want []", got)
+	}
+
+	truncated := slice.TruncateTo(input, 2)
+	_ = append(truncated, 99)
+	if input[2] != 3 {
+		t.Errorf("TruncateTo() result must not alias input on append, got %v", input)
+	}
+}
+
+func TestTakeLast(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+
+	if got := slice.TakeLast(input, 2); !slicesEqual(got, []int{3, 4}) {
+		t.Errorf("TakeLast() = %v, want [3 4]", got)
+	}
+	if got := slice.TakeLast(input, 10); !slicesEqual(got, input) {
+		t.Errorf("TakeLast() = %v, want %v", got, input)
+	}
+	if got := slice.TakeLast(input, -1); len(got) != 0 {
+		t.Errorf("TakeLast() = %v, want []", got)
+	}
+}
+
+func TestDropLast(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+
+	if got := slice.DropLast(input, 1); !slicesEqual(got, []int{1, 2, 3}) {
+		t.Errorf("DropLast() = %v, want [1 2 3]", got)
+	}
+	if got := slice.DropLast(input, -3); !slicesEqual(got, input) {
+		t.Errorf("DropLast() = %v, want %v", got, input)
+	}
+	if got := slice.DropLast(input, 10); len(got) != 0 {
+		t.Errorf("DropLast() = %v, want []", got)
+	}
+	if got := slice.DropLast[int](nil, 1); len(got) != 0 {
+		t.Errorf("DropLast(nil) = %v, want []", got)
+	}
+
+	dropped := slice.DropLast(input, 2)
+	_ = append(dropped, 99)
+	if input[2] != 3 {
+		t.Errorf("DropLast() result must not alias input on append, got %v", input)
+	}
+}
@@ -0,0 +1,62 @@
+package slice
+
+import "iter"
+
+// PageMeta describes a page of a paginated slice.
+type PageMeta struct {
+	Page       int  // 1-based page number
+	PerPage    int  // maximum number of items per page
+	Total      int  // total number of items across all pages
+	TotalPages int  // number of pages
+	HasPrev    bool // whether a previous page exists
+	HasNext    bool // whether a next page exists
+}
+
+// Paginate returns the items of the given 1-based page along with its metadata.
+// If page < 1 it defaults to 1, and if perPage <= 0 it defaults to 1.
+// A page past the end returns no items. The returned items share memory with the input.
+func Paginate[In any](input []In, page, perPage int) ([]In, PageMeta) {
+	if page < 1 {
+		page = 1
+	}
+	if perPage <= 0 {
+		perPage = 1
+	}
+	total := len(input)
+	meta := PageMeta{
+		Page:       page,
+		PerPage:    perPage,
+		Total:      total,
+		TotalPages: total / perPage,
+	}
+	// Avoid total + perPage - 1, which overflows for huge perPage values.
+	if total%perPage != 0 {
+		meta.TotalPages++
+	}
+	meta.HasPrev = page > 1
+	meta.HasNext = page < meta.TotalPages
+
+	// Check the page against the last one before multiplying, so huge pages cannot overflow.
+	if total == 0 || page-1 > (total-1)/perPage {
+		return nil, meta
+	}
+	start := (page - 1) * perPage
+	end := start + min(perPage, total-start)
+	return input[start:end:end], meta
+}
+
+// Pages returns an iterator over every page of the slice, in order.
+// If perPage <= 0 it defaults to 1. An empty slice yields no pages.
+func Pages[In any](input []In, perPage int) iter.Seq2[PageMeta, []In] {
+	return func(yield func(PageMeta, []In) bool) {
+		for page := 1; ; page++ {
+			items, meta := Paginate(input, page, perPage)
+			if len(items) == 0 {
+				return
+			}
+			if !yield(meta, items) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package slice_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestPaginate(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7}
+
+	tests := []struct {
+		name     string
+		page     int
+		perPage  int
+		want     []int
+		wantMeta slice.PageMeta
+	}{
+		{
+			name:     "first page",
+			page:     1,
+			perPage:  3,
+			want:     []int{1, 2, 3},
+			wantMeta: slice.PageMeta{Page: 1, PerPage: 3, Total: 7, TotalPages: 3, HasPrev: false, HasNext: true},
+		},
+		{
+			name:     "middle page",
+			page:     2,
+			perPage:  3,
+			want:     []int{4, 5, 6},
+			wantMeta: slice.PageMeta{Page: 2, PerPage: 3, Total: 7, TotalPages: 3, HasPrev: true, HasNext: true},
+		},
+		{
+			name:     "last partial page",
+			page:     3,
+			perPage:  3,
+			want:     []int{7},
+			wantMeta: slice.PageMeta{Page: 3, PerPage: 3, Total: 7, TotalPages: 3, HasPrev: true, HasNext: false},
+		},
+		{
+			name:     "past the end",
+			page:     4,
+			perPage:  3,
+			want:     nil,
+			wantMeta: slice.PageMeta{Page: 4, PerPage: 3, Total: 7, TotalPages: 3, HasPrev: true, HasNext: false},
+		},
+		{
+			name:     "invalid page and size",
+			page:     0,
+			perPage:  0,
+			want:     []int{1},
+			wantMeta: slice.PageMeta{Page: 1, PerPage: 1, Total: 7, TotalPages: 7, HasPrev: false, HasNext: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, meta := slice.Paginate(input, tt.page, tt.perPage)
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("Paginate() items = %v, want %v", got, tt.want)
+			}
+			if meta != tt.wantMeta {
+				t.Errorf("Paginate() meta = %+v, want %+v", meta, tt.wantMeta)
+			}
+		})
+	}
+
+	_, meta := slice.Paginate([]int{}, 1, 10)
+	if meta.TotalPages != 0 || meta.HasNext {
+		t.Errorf("Paginate() of empty slice meta = %+v", meta)
+	}
+}
+
+func TestPaginate_HugeValues(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7}
+
+	for _, page := range []int{math.MaxInt / 2, math.MaxInt} {
+		items, meta := slice.Paginate(input, page, 3)
+		if items != nil || meta.TotalPages != 3 || meta.HasNext {
+			t.Errorf("Paginate(page=%d) = %v, %+v, want no items", page, items, meta)
+		}
+	}
+
+	items, meta := slice.Paginate(input, 1, math.MaxInt)
+	if !slicesEqual(items, input) {
+		t.Errorf("Paginate(perPage=MaxInt) items = %v, want %v", items, input)
+	}
+	if meta.TotalPages != 1 || meta.HasNext {
+		t.Errorf("Paginate(perPage=MaxInt) meta = %+v, want 1 page", meta)
+	}
+
+	items, _ = slice.Paginate(input, math.MaxInt, math.MaxInt)
+	if items != nil {
+		t.Errorf("Paginate(MaxInt, MaxInt) = %v, want nil", items)
+	}
+}
+
+func TestPages(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5}
+	var (
+		pages [][]int
+		last  slice.PageMeta
+	)
+	for meta, items := range slice.Pages(input, 2) {
+		pages = append(pages, items)
+		last = meta
+	}
+	want := [][]int{{1, 2}, {3, 4}, {5}}
+	if !slicesEqual2D(pages, want) {
+		t.Errorf("Pages() = %v, want %v", pages, want)
+	}
+	if last.Page != 3 || last.HasNext {
+		t.Errorf("Pages() last meta = %+v", last)
+	}
+
+	for range slice.Pages([]int{}, 2) {
+		t.Error("Pages() of empty slice should yield nothing")
+	}
+}
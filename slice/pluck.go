@@ -0,0 +1,27 @@
+package slice
+
+// Pluck returns the field selected by field from each element, in order.
+// Returns nil if the input slice is empty.
+func Pluck[In, Out any](input []In, field func(item In) Out) []Out {
+	return Map(input, field)
+}
+
+// PluckComparable returns the distinct values selected by field, in order of first occurrence.
+// Returns nil if the input slice is empty.
+// It is the usual first step before querying by a set of IDs.
+func PluckComparable[In any, K comparable](input []In, field func(item In) K) []K {
+	if len(input) == 0 || field == nil {
+		return nil
+	}
+	seen := make(map[K]struct{}, len(input))
+	result := make([]K, 0, len(input))
+	for _, item := range input {
+		k := field(item)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		result = append(result, k)
+	}
+	return result
+}
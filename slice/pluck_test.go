@@ -0,0 +1,40 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestPluck(t *testing.T) {
+	users := []user{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob"}, {ID: 1, Name: "Alice"}}
+
+	names := slice.Pluck(users, func(u user) string { return u.Name })
+	if !slicesEqual(names, []string{"Alice", "Bob", "Alice"}) {
+		t.Errorf("Pluck() = %v", names)
+	}
+	if got := slice.Pluck(nil, func(u user) int { return u.ID }); got != nil {
+		t.Errorf("Pluck(nil) = %v, want nil", got)
+	}
+}
+
+func TestPluckComparable(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []user
+		want  []int
+	}{
+		{name: "dedupes in first-occurrence order", input: []user{{ID: 3}, {ID: 1}, {ID: 3}, {ID: 2}, {ID: 1}}, want: []int{3, 1, 2}},
+		{name: "all distinct", input: []user{{ID: 1}, {ID: 2}}, want: []int{1, 2}},
+		{name: "empty", input: []user{}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slice.PluckComparable(tt.input, func(u user) int { return u.ID })
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("PluckComparable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
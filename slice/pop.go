@@ -0,0 +1,31 @@
+package slice
+
+// PopFront returns the first element of the slice and a copy of the remaining elements.
+// If the slice is empty, it returns the zero value, nil and false.
+func PopFront[In any](input []In) (head In, rest []In, ok bool) {
+	if len(input) == 0 {
+		return head, nil, false
+	}
+	rest = make([]In, len(input)-1)
+	copy(rest, input[1:])
+	return input[0], rest, true
+}
+
+// PopBack returns the last element of the slice and a copy of the preceding elements.
+// If the slice is empty, it returns the zero value, nil and false.
+func PopBack[In any](input []In) (tail In, rest []In, ok bool) {
+	if len(input) == 0 {
+		return tail, nil, false
+	}
+	last := len(input) - 1
+	rest = make([]In, last)
+	copy(rest, input[:last])
+	return input[last], rest, true
+}
+
+// PushFront returns a new slice with items placed before the elements of input.
+func PushFront[In any](input []In, items ...In) []In {
+	result := make([]In, 0, len(items)+len(input))
+	result = append(result, items...)
+	return append(result, input...)
+}
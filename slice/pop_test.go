@@ -0,0 +1,55 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestPopFront(t *testing.T) {
+	input := []int{1, 2, 3}
+	head, rest, ok := slice.PopFront(input)
+	if !ok || head != 1 || !slicesEqual(rest, []int{2, 3}) {
+		t.Errorf("PopFront() = %v, %v, %v", head, rest, ok)
+	}
+	rest[0] = 99
+	if input[1] != 2 {
+		t.Error("PopFront() rest shares memory with input")
+	}
+
+	head, rest, ok = slice.PopFront([]int{7})
+	if !ok || head != 7 || len(rest) != 0 {
+		t.Errorf("PopFront() single = %v, %v, %v", head, rest, ok)
+	}
+
+	if _, rest, ok := slice.PopFront[int](nil); ok || rest != nil {
+		t.Errorf("PopFront(nil) = %v, %v", rest, ok)
+	}
+}
+
+func TestPopBack(t *testing.T) {
+	input := []string{"a", "b", "c"}
+	tail, rest, ok := slice.PopBack(input)
+	if !ok || tail != "c" || !slicesEqual(rest, []string{"a", "b"}) {
+		t.Errorf("PopBack() = %v, %v, %v", tail, rest, ok)
+	}
+	rest = append(rest, "x")
+	if input[2] != "c" {
+		t.Error("PopBack() rest shares memory with input")
+	}
+
+	if _, rest, ok := slice.PopBack([]string{}); ok || rest != nil {
+		t.Errorf("PopBack(empty) = %v, %v", rest, ok)
+	}
+}
+
+func TestPushFront(t *testing.T) {
+	input := []int{3, 4}
+	got := slice.PushFront(input, 1, 2)
+	if !slicesEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("PushFront() = %v", got)
+	}
+	if got := slice.PushFront[int](nil); len(got) != 0 {
+		t.Errorf("PushFront(nil) = %v", got)
+	}
+}
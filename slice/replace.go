@@ -0,0 +1,68 @@
+package slice
+
+// ReplaceAll returns a copy of the slice with every element equal to old replaced by new.
+// Returns nil if the input slice is nil.
+func ReplaceAll[In comparable](input []In, old, new In) []In {
+	return ReplaceN(input, old, new, -1)
+}
+
+// ReplaceN returns a copy of the slice with the first n elements equal to old replaced by new.
+// If n < 0, all matching elements are replaced. Returns nil if the input slice is nil.
+func ReplaceN[In comparable](input []In, old, new In, n int) []In {
+	if input == nil {
+		return nil
+	}
+	result := make([]In, len(input))
+	copy(result, input)
+	ReplaceNInPlace(result, old, new, n)
+	return result
+}
+
+// ReplaceBy returns a copy of the slice with every element that satisfies predicate replaced by new.
+// Returns nil if the input slice is nil, and an unchanged copy if predicate is nil.
+func ReplaceBy[In any](input []In, predicate func(item In) bool, new In) []In {
+	if input == nil {
+		return nil
+	}
+	result := make([]In, len(input))
+	copy(result, input)
+	ReplaceByInPlace(result, predicate, new)
+	return result
+}
+
+// ReplaceAllInPlace replaces every element equal to old with new and returns the number of replacements.
+func ReplaceAllInPlace[In comparable](input []In, old, new In) int {
+	return ReplaceNInPlace(input, old, new, -1)
+}
+
+// ReplaceNInPlace replaces the first n elements equal to old with new and returns the number of
+// replacements. If n < 0, all matching elements are replaced.
+func ReplaceNInPlace[In comparable](input []In, old, new In, n int) int {
+	count := 0
+	for i := range input {
+		if count == n {
+			break
+		}
+		if input[i] == old {
+			input[i] = new
+			count++
+		}
+	}
+	return count
+}
+
+// ReplaceByInPlace replaces every element that satisfies predicate with new and returns the
+// number of replacements. A nil predicate replaces nothing.
+func ReplaceByInPlace[In any](input []In, predicate func(item In) bool, new In) int {
+	if predicate == nil {
+		return 0
+	}
+	count := 0
+	for i := range input {
+		if predicate(input[i]) {
+			input[i] = new
+			count++
+		}
+	}
+	return count
+}
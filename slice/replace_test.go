@@ -0,0 +1,81 @@
+package slice_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestReplaceN(t *testing.T) {
+	input := []string{"n/a", "x", "n/a", "y", "n/a"}
+
+	tests := []struct {
+		name string
+		n    int
+		want []string
+	}{
+		{name: "all", n: -1, want: []string{"", "x", "", "y", ""}},
+		{name: "first two", n: 2, want: []string{"", "x", "", "y", "n/a"}},
+		{name: "zero", n: 0, want: []string{"n/a", "x", "n/a", "y", "n/a"}},
+		{name: "more than matches", n: 10, want: []string{"", "x", "", "y", ""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slice.ReplaceN(input, "n/a", "", tt.n)
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("ReplaceN() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if input[0] != "n/a" {
+		t.Error("ReplaceN() modified the input slice")
+	}
+	if got := slice.ReplaceN[string](nil, "a", "b", -1); got != nil {
+		t.Errorf("ReplaceN(nil) = %v, want nil", got)
+	}
+}
+
+func TestReplaceAll(t *testing.T) {
+	got := slice.ReplaceAll([]int{0, 1, 0, 2}, 0, -1)
+	if !slicesEqual(got, []int{-1, 1, -1, 2}) {
+		t.Errorf("ReplaceAll() = %v", got)
+	}
+}
+
+func TestReplaceBy(t *testing.T) {
+	input := []string{"ok", "  ", "fine", ""}
+	blank := func(s string) bool { return strings.TrimSpace(s) == "" }
+
+	got := slice.ReplaceBy(input, blank, "-")
+	if !slicesEqual(got, []string{"ok", "-", "fine", "-"}) {
+		t.Errorf("ReplaceBy() = %q", got)
+	}
+	if input[1] != "  " {
+		t.Error("ReplaceBy() modified the input slice")
+	}
+	if got := slice.ReplaceBy(nil, blank, "-"); got != nil {
+		t.Errorf("ReplaceBy(nil) = %v, want nil", got)
+	}
+	if got := slice.ReplaceBy(input, nil, "-"); !slicesEqual(got, input) {
+		t.Errorf("ReplaceBy() with nil predicate = %q, want an unchanged copy", got)
+	}
+}
+
+func TestReplaceInPlace(t *testing.T) {
+	nums := []int{1, 2, 1, 3, 1}
+	if n := slice.ReplaceNInPlace(nums, 1, 9, 2); n != 2 || !slicesEqual(nums, []int{9, 2, 9, 3, 1}) {
+		t.Errorf("ReplaceNInPlace() = %d, %v", n, nums)
+	}
+	if n := slice.ReplaceAllInPlace(nums, 9, 0); n != 2 || !slicesEqual(nums, []int{0, 2, 0, 3, 1}) {
+		t.Errorf("ReplaceAllInPlace() = %d, %v", n, nums)
+	}
+	if n := slice.ReplaceByInPlace(nums, func(v int) bool { return v > 1 }, 1); n != 2 || !slicesEqual(nums, []int{0, 1, 0, 1, 1}) {
+		t.Errorf("ReplaceByInPlace() = %d, %v", n, nums)
+	}
+	if n := slice.ReplaceByInPlace(nums, nil, 7); n != 0 || !slicesEqual(nums, []int{0, 1, 0, 1, 1}) {
+		t.Errorf("ReplaceByInPlace() with nil predicate = %d, %v", n, nums)
+	}
+}
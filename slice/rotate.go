@@ -0,0 +1,61 @@
+package slice
+
+import (
+	"iter"
+	"slices"
+)
+
+// Rotate returns a new slice with the elements rotated left by n positions.
+// A negative n rotates right. n may be larger than the slice length.
+// Returns nil if the input slice is nil.
+func Rotate[In any](input []In, n int) []In {
+	if input == nil {
+		return nil
+	}
+	result := make([]In, len(input))
+	if len(input) == 0 {
+		return result
+	}
+	n = normalizeShift(n, len(input))
+	copy(result, input[n:])
+	copy(result[len(input)-n:], input[:n])
+	return result
+}
+
+// RotateInPlace rotates the elements of the slice left by n positions without allocating.
+// A negative n rotates right. n may be larger than the slice length.
+func RotateInPlace[In any](input []In, n int) {
+	if len(input) == 0 {
+		return
+	}
+	n = normalizeShift(n, len(input))
+	slices.Reverse(input[:n])
+	slices.Reverse(input[n:])
+	slices.Reverse(input)
+}
+
+// Cycle returns an iterator that yields the elements of the slice in order, repeating forever.
+// The caller is expected to stop iterating; an empty slice yields nothing.
+func Cycle[In any](input []In) iter.Seq[In] {
+	return func(yield func(In) bool) {
+		if len(input) == 0 {
+			return
+		}
+		for {
+			for _, item := range input {
+				if !yield(item) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// normalizeShift maps any left shift n onto the range [0, size).
+func normalizeShift(n, size int) int {
+	n %= size
+	if n < 0 {
+		n += size
+	}
+	return n
+}
@@ -0,0 +1,63 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		n     int
+		want  []int
+	}{
+		{name: "nil input", input: nil, n: 1, want: nil},
+		{name: "empty input", input: []int{}, n: 1, want: []int{}},
+		{name: "zero", input: []int{1, 2, 3}, n: 0, want: []int{1, 2, 3}},
+		{name: "left", input: []int{1, 2, 3, 4}, n: 1, want: []int{2, 3, 4, 1}},
+		{name: "right", input: []int{1, 2, 3, 4}, n: -1, want: []int{4, 1, 2, 3}},
+		{name: "larger than length", input: []int{1, 2, 3}, n: 5, want: []int{3, 1, 2}},
+		{name: "full turn", input: []int{1, 2, 3}, n: -3, want: []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var original []int
+			if tt.input != nil {
+				original = append([]int{}, tt.input...)
+			}
+			got := slice.Rotate(tt.input, tt.n)
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("Rotate() = %v, want %v", got, tt.want)
+			}
+			if !slicesEqual(tt.input, original) {
+				t.Errorf("Rotate() modified input: %v", tt.input)
+			}
+
+			slice.RotateInPlace(tt.input, tt.n)
+			if !slicesEqual(tt.input, tt.want) {
+				t.Errorf("RotateInPlace() = %v, want %v", tt.input, tt.want)
+			}
+		})
+	}
+}
+
+func TestCycle(t *testing.T) {
+	var got []string
+	for w := range slice.Cycle([]string{"a", "b", "c"}) {
+		if len(got) == 7 {
+			break
+		}
+		got = append(got, w)
+	}
+	want := []string{"a", "b", "c", "a", "b", "c", "a"}
+	if !slicesEqual(got, want) {
+		t.Errorf("Cycle() = %v, want %v", got, want)
+	}
+
+	for range slice.Cycle[int](nil) {
+		t.Fatal("Cycle() of empty slice should yield nothing")
+	}
+}
@@ -0,0 +1,54 @@
+package slice
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// StratifiedSample picks about fraction of the elements of each group defined by keyFn,
+// so that every group is represented in proportion. Each group contributes
+// round(fraction * size) elements, and at least one if fraction > 0. fraction is
+// clamped to [0, 1]. The picks depend only on the input and seed, and are returned
+// in input order.
+func StratifiedSample[In any, K comparable](input []In, keyFn func(item In) K, fraction float64, seed uint64) []In {
+	fraction = min(max(fraction, 0), 1)
+	if len(input) == 0 || keyFn == nil || fraction == 0 {
+		return []In{}
+	}
+
+	// Group item indexes by key, keeping keys in order of first appearance.
+	var (
+		groups [][]int
+		byKey  = make(map[K]int)
+	)
+	for i, item := range input {
+		k := keyFn(item)
+		g, ok := byKey[k]
+		if !ok {
+			g = len(groups)
+			byKey[k] = g
+			groups = append(groups, nil)
+		}
+		groups[g] = append(groups[g], i)
+	}
+
+	r := rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15))
+	var picked []int
+	for _, indexes := range groups {
+		n := max(int(math.Round(fraction*float64(len(indexes)))), 1)
+		// Partial Fisher-Yates shuffle: the first n positions end up holding the sample.
+		for i := range n {
+			j := i + r.IntN(len(indexes)-i)
+			indexes[i], indexes[j] = indexes[j], indexes[i]
+		}
+		picked = append(picked, indexes[:n]...)
+	}
+	slices.Sort(picked)
+
+	result := make([]In, len(picked))
+	for i, idx := range picked {
+		result[i] = input[idx]
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+type row struct {
+	ID     int
+	Region string
+}
+
+func sampleRows() []row {
+	var rows []row
+	for i := range 100 {
+		region := "us"
+		switch {
+		case i%10 == 0:
+			region = "eu"
+		case i == 7:
+			region = "ap"
+		}
+		rows = append(rows, row{ID: i, Region: region})
+	}
+	return rows
+}
+
+func TestStratifiedSample(t *testing.T) {
+	rows := sampleRows() // 10 eu, 1 ap, 89 us
+	byRegion := func(r row) string { return r.Region }
+
+	got := slice.StratifiedSample(rows, byRegion, 0.2, 42)
+	counts := map[string]int{}
+	for _, r := range got {
+		counts[r.Region]++
+	}
+	if counts["eu"] != 2 || counts["us"] != 18 || counts["ap"] != 1 {
+		t.Errorf("unexpected per-region counts %v", counts)
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i-1].ID >= got[i].ID {
+			t.Fatalf("expected picks in input order, got %v", got)
+		}
+	}
+
+	again := slice.StratifiedSample(rows, byRegion, 0.2, 42)
+	if !slicesEqual(got, again) {
+		t.Error("expected the same seed to give the same sample")
+	}
+	other := slice.StratifiedSample(rows, byRegion, 0.2, 43)
+	if slicesEqual(got, other) {
+		t.Error("expected a different seed to give a different sample")
+	}
+}
+
+func TestStratifiedSample_Bounds(t *testing.T) {
+	rows := sampleRows()
+	byRegion := func(r row) string { return r.Region }
+
+	if got := slice.StratifiedSample(rows, byRegion, 0, 1); len(got) != 0 {
+		t.Errorf("fraction 0 returned %d rows", len(got))
+	}
+	if got := slice.StratifiedSample(rows, byRegion, 5, 1); !slicesEqual(got, rows) {
+		t.Errorf("fraction above 1 should return every row, got %d", len(got))
+	}
+	if got := slice.StratifiedSample[row, string](nil, byRegion, 0.5, 1); len(got) != 0 {
+		t.Errorf("nil input returned %v", got)
+	}
+}
@@ -0,0 +1,76 @@
+package slice
+
+import (
+	"cmp"
+	"sort"
+)
+
+// BinarySearchBy searches for target in a slice sorted in ascending order by keyFn.
+// It returns the position where target is found, or the position where target would
+// be inserted to keep the slice sorted, and a bool reporting whether it was found.
+func BinarySearchBy[In any, K cmp.Ordered](input []In, target K, keyFn func(item In) K) (int, bool) {
+	if len(input) == 0 || keyFn == nil {
+		return 0, false
+	}
+	i := sort.Search(len(input), func(i int) bool {
+		return keyFn(input[i]) >= target
+	})
+	return i, i < len(input) && keyFn(input[i]) == target
+}
+
+// InsertSorted inserts value into a slice sorted according to less and returns the
+// resulting slice, which stays sorted.
+// Equal elements keep their insertion order: value is placed after any element equal to it.
+// Like append, the input slice may be modified in place.
+func InsertSorted[In any](input []In, value In, less func(a, b In) bool) []In {
+	if less == nil {
+		return append(input, value)
+	}
+	i := sort.Search(len(input), func(i int) bool {
+		return less(value, input[i])
+	})
+	var zero In
+	input = append(input, zero)
+	copy(input[i+1:], input[i:])
+	input[i] = value
+	return input
+}
+
+// IsSorted reports whether the slice is sorted in ascending order.
+func IsSorted[In cmp.Ordered](input []In) bool {
+	for i := 1; i < len(input); i++ {
+		if input[i] < input[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSortedBy reports whether the slice is sorted in ascending order by keyFn.
+// It can be used to validate the precondition of BinarySearchBy.
+func IsSortedBy[In any, K cmp.Ordered](input []In, keyFn func(item In) K) bool {
+	if len(input) < 2 || keyFn == nil {
+		return true
+	}
+	prev := keyFn(input[0])
+	for _, item := range input[1:] {
+		cur := keyFn(item)
+		if cur < prev {
+			return false
+		}
+		prev = cur
+	}
+	return true
+}
+
+// SortIfNeeded sorts the slice in place in ascending order by keyFn, unless it is
+// already sorted, and reports whether it had to sort. The sort is stable.
+func SortIfNeeded[In any, K cmp.Ordered](input []In, keyFn func(item In) K) bool {
+	if IsSortedBy(input, keyFn) {
+		return false
+	}
+	sort.SliceStable(input, func(i, j int) bool {
+		return keyFn(input[i]) < keyFn(input[j])
+	})
+	return true
+}
@@ -0,0 +1,123 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+type user struct {
+	ID   int
+	Name string
+}
+
+func TestBinarySearchBy(t *testing.T) {
+	input := []user{{1, "a"}, {3, "b"}, {5, "c"}, {7, "d"}}
+	byID := func(u user) int { return u.ID }
+
+	tests := []struct {
+		name      string
+		target    int
+		wantIndex int
+		wantFound bool
+	}{
+		{name: "first", target: 1, wantIndex: 0, wantFound: true},
+		{name: "middle", target: 5, wantIndex: 2, wantFound: true},
+		{name: "last", target: 7, wantIndex: 3, wantFound: true},
+		{name: "missing before", target: 0, wantIndex: 0, wantFound: false},
+		{name: "missing between", target: 4, wantIndex: 2, wantFound: false},
+		{name: "missing after", target: 9, wantIndex: 4, wantFound: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i, found := slice.BinarySearchBy(input, tt.target, byID)
+			if i != tt.wantIndex || found != tt.wantFound {
+				t.Errorf("BinarySearchBy() = (%d, %v), want (%d, %v)", i, found, tt.wantIndex, tt.wantFound)
+			}
+		})
+	}
+
+	if i, found := slice.BinarySearchBy(nil, 1, byID); i != 0 || found {
+		t.Errorf("BinarySearchBy(nil) = (%d, %v), want (0, false)", i, found)
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	tests := []struct {
+		name  string
+		input []int
+		value int
+		want  []int
+	}{
+		{name: "nil input", input: nil, value: 1, want: []int{1}},
+		{name: "front", input: []int{2, 3}, value: 1, want: []int{1, 2, 3}},
+		{name: "middle", input: []int{1, 3}, value: 2, want: []int{1, 2, 3}},
+		{name: "back", input: []int{1, 2}, value: 3, want: []int{1, 2, 3}},
+		{name: "duplicate", input: []int{1, 2, 3}, value: 2, want: []int{1, 2, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slice.InsertSorted(tt.input, tt.value, less)
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("InsertSorted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInsertSorted_Stable(t *testing.T) {
+	byID := func(a, b user) bool { return a.ID < b.ID }
+	var users []user
+	users = slice.InsertSorted(users, user{2, "first"}, byID)
+	users = slice.InsertSorted(users, user{1, "x"}, byID)
+	users = slice.InsertSorted(users, user{2, "second"}, byID)
+
+	want := []user{{1, "x"}, {2, "first"}, {2, "second"}}
+	if !slicesEqual(users, want) {
+		t.Errorf("InsertSorted() = %v, want %v", users, want)
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	if !slice.IsSorted([]int{1, 2, 2, 3}) {
+		t.Error("IsSorted() = false for sorted input")
+	}
+	if slice.IsSorted([]int{1, 3, 2}) {
+		t.Error("IsSorted() = true for unsorted input")
+	}
+	if !slice.IsSorted[string](nil) {
+		t.Error("IsSorted() = false for nil input")
+	}
+}
+
+func TestIsSortedBy(t *testing.T) {
+	byID := func(u user) int { return u.ID }
+	if !slice.IsSortedBy([]user{{1, "b"}, {2, "a"}}, byID) {
+		t.Error("IsSortedBy() = false for sorted input")
+	}
+	if slice.IsSortedBy([]user{{2, "a"}, {1, "b"}}, byID) {
+		t.Error("IsSortedBy() = true for unsorted input")
+	}
+}
+
+func TestSortIfNeeded(t *testing.T) {
+	byID := func(u user) int { return u.ID }
+
+	sorted := []user{{1, "a"}, {2, "b"}}
+	if slice.SortIfNeeded(sorted, byID) {
+		t.Error("SortIfNeeded() sorted an already sorted slice")
+	}
+
+	users := []user{{3, "c"}, {1, "first"}, {2, "b"}, {1, "second"}}
+	if !slice.SortIfNeeded(users, byID) {
+		t.Error("SortIfNeeded() did not report sorting")
+	}
+	want := []user{{1, "first"}, {1, "second"}, {2, "b"}, {3, "c"}}
+	if !slicesEqual(users, want) {
+		t.Errorf("SortIfNeeded() = %v, want %v", users, want)
+	}
+}
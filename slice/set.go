@@ -0,0 +1,78 @@
+package slice
+
+// SymmetricDifference returns the elements that appear in exactly one of the two slices.
+// Elements only in a come first, followed by elements only in b, each in order of first
+// occurrence and without duplicates.
+func SymmetricDifference[In comparable](a, b []In) []In {
+	inA := make(map[In]struct{}, len(a))
+	for _, item := range a {
+		inA[item] = struct{}{}
+	}
+	inB := make(map[In]struct{}, len(b))
+	for _, item := range b {
+		inB[item] = struct{}{}
+	}
+
+	var (
+		result []In
+		seen   = make(map[In]struct{})
+	)
+	collect := func(input []In, other map[In]struct{}) {
+		for _, item := range input {
+			if _, ok := other[item]; ok {
+				continue
+			}
+			if _, ok := seen[item]; ok {
+				continue
+			}
+			seen[item] = struct{}{}
+			result = append(result, item)
+		}
+	}
+	collect(a, inB)
+	collect(b, inA)
+	return result
+}
+
+// AreDisjoint returns true if the two slices have no element in common.
+// Returns true if either slice is empty.
+func AreDisjoint[In comparable](a, b []In) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return true
+	}
+	if len(b) < len(a) {
+		a, b = b, a
+	}
+	small := make(map[In]struct{}, len(a))
+	for _, item := range a {
+		small[item] = struct{}{}
+	}
+	for _, item := range b {
+		if _, ok := small[item]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Without returns the elements of input that are not among values, in their original order.
+// It builds a set from values, so it runs in O(len(input) + len(values)).
+func Without[In comparable](input []In, values ...In) []In {
+	set := make(map[In]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return ExcludeBySet(input, set)
+}
+
+// ExcludeBySet returns the elements of input that are not in set, in their original order.
+// Use it instead of Without to reuse a set across calls.
+func ExcludeBySet[In comparable](input []In, set map[In]struct{}) []In {
+	result := make([]In, 0, len(input))
+	for _, item := range input {
+		if _, ok := set[item]; !ok {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,72 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestSymmetricDifference(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want []int
+	}{
+		{name: "nil inputs", a: nil, b: nil, want: nil},
+		{name: "one empty", a: []int{1, 2}, b: nil, want: []int{1, 2}},
+		{name: "overlap", a: []int{1, 2, 3}, b: []int{3, 4, 2, 5}, want: []int{1, 4, 5}},
+		{name: "duplicates", a: []int{1, 1, 2}, b: []int{3, 3}, want: []int{1, 2, 3}},
+		{name: "identical", a: []int{1, 2}, b: []int{2, 1}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slice.SymmetricDifference(tt.a, tt.b)
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("SymmetricDifference() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAreDisjoint(t *testing.T) {
+	if !slice.AreDisjoint([]int{1, 2}, []int{3, 4}) {
+		t.Error("AreDisjoint() = false for disjoint inputs")
+	}
+	if slice.AreDisjoint([]int{1, 2, 3}, []int{3}) {
+		t.Error("AreDisjoint() = true for overlapping inputs")
+	}
+	if !slice.AreDisjoint(nil, []string{"a"}) {
+		t.Error("AreDisjoint() = false for empty input")
+	}
+}
+
+func TestWithout(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []int
+		values []int
+		want   []int
+	}{
+		{name: "removes every occurrence", input: []int{1, 2, 3, 2, 4}, values: []int{2, 4}, want: []int{1, 3}},
+		{name: "no values", input: []int{1, 2}, values: nil, want: []int{1, 2}},
+		{name: "all removed", input: []int{1, 1}, values: []int{1}, want: []int{}},
+		{name: "nil input", input: nil, values: []int{1}, want: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slice.Without(tt.input, tt.values...); !slicesEqual(got, tt.want) {
+				t.Errorf("Without() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExcludeBySet(t *testing.T) {
+	banned := map[string]struct{}{"bob": {}, "eve": {}}
+	got := slice.ExcludeBySet([]string{"alice", "bob", "carol", "eve"}, banned)
+	if want := []string{"alice", "carol"}; !slicesEqual(got, want) {
+		t.Errorf("ExcludeBySet() = %v, want %v", got, want)
+	}
+}
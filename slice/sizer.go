@@ -0,0 +1,126 @@
+package slice
+
+import (
+	"sync"
+	"time"
+)
+
+// Sizer decides the size of the next chunk processed by ForEachChunk.
+// Calls are serialized, so implementations need not be safe for concurrent use.
+type Sizer interface {
+	// NextSize returns the size of the next chunk, given the size of the last completed
+	// chunk and how long its handler took. Values below 1 are treated as 1.
+	NextSize(lastSize int, elapsed time.Duration) int
+}
+
+// SizerFunc adapts a function to the Sizer interface.
+type SizerFunc func(lastSize int, elapsed time.Duration) int
+
+// NextSize implements Sizer.
+func (f SizerFunc) NextSize(lastSize int, elapsed time.Duration) int {
+	return f(lastSize, elapsed)
+}
+
+// latencySizer scales chunk sizes towards a per-chunk latency target.
+type latencySizer struct {
+	target  time.Duration
+	minSize int
+	maxSize int
+}
+
+// LatencySizer returns a Sizer that scales chunk sizes so each chunk takes about target.
+// Sizes change by at most a factor of 2 per chunk to avoid oscillation, and are kept
+// within [minSize, maxSize]. A maxSize <= 0 means no upper bound.
+func LatencySizer(target time.Duration, minSize, maxSize int) Sizer {
+	return &latencySizer{target: target, minSize: max(minSize, 1), maxSize: maxSize}
+}
+
+// NextSize implements Sizer.
+func (s *latencySizer) NextSize(lastSize int, elapsed time.Duration) int {
+	var next int
+	if elapsed <= 0 {
+		next = lastSize * 2
+	} else {
+		next = int(float64(lastSize) * float64(s.target) / float64(elapsed))
+		next = min(max(next, lastSize/2), lastSize*2)
+	}
+	if s.maxSize > 0 {
+		next = min(next, s.maxSize)
+	}
+	return max(next, s.minSize)
+}
+
+// forEachAdaptiveChunk implements ForEachChunk when a Sizer is configured.
+// Chunks are carved from the input as handlers become available, each sized
+// from the most recently completed chunk.
+func forEachAdaptiveChunk[In any](input []In, chunkSize int, concurrency int, handler func(chunk []In) error, o options, stats *OpStats) error {
+	var (
+		mu     sync.Mutex
+		size   = max(chunkSize, 1)
+		first  error
+		offset int
+	)
+	run := func(i int, chunk []In) error {
+		start := time.Now()
+		err := safeCall(i, func() error { return handler(chunk) })
+		elapsed := time.Since(start)
+
+		mu.Lock()
+		defer mu.Unlock()
+		size = max(o.sizer.NextSize(len(chunk), elapsed), 1)
+		stats.Chunks++
+		if err != nil {
+			stats.Errors++
+		}
+		return err
+	}
+	nextChunk := func() []In {
+		mu.Lock()
+		defer mu.Unlock()
+		end := min(offset+size, len(input))
+		chunk := input[offset:end:end]
+		offset = end
+		return chunk
+	}
+
+	if concurrency <= 1 {
+		for i := 0; offset < len(input); i++ {
+			if err := run(i, nextChunk()); err != nil {
+				return o.handleError(err)
+			}
+		}
+		return nil
+	}
+
+	var (
+		wg       sync.WaitGroup
+		errMu    sync.Mutex
+		panicErr *PanicError
+		sem      = make(chan struct{}, concurrency)
+	)
+	for i := 0; offset < len(input); i++ {
+		sem <- struct{}{} // Acquire token
+		chunk := nextChunk()
+		wg.Add(1)
+		go func(i int, c []In) {
+			defer wg.Done()
+			defer func() { <-sem }() // Release token
+			if err := run(i, c); err != nil {
+				errMu.Lock()
+				defer errMu.Unlock()
+				if first == nil {
+					first = err
+				}
+				if pe, ok := err.(*PanicError); ok && panicErr == nil {
+					panicErr = pe
+				}
+			}
+		}(i, chunk)
+	}
+
+	wg.Wait()
+	if panicErr != nil && o.repanic {
+		panic(panicErr)
+	}
+	return first
+}
@@ -0,0 +1,109 @@
+package slice_test
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestLatencySizer(t *testing.T) {
+	s := slice.LatencySizer(100*time.Millisecond, 2, 50)
+
+	tests := []struct {
+		name     string
+		lastSize int
+		elapsed  time.Duration
+		want     int
+	}{
+		{name: "on target", lastSize: 10, elapsed: 100 * time.Millisecond, want: 10},
+		{name: "too fast grows", lastSize: 10, elapsed: 80 * time.Millisecond, want: 12},
+		{name: "growth capped at 2x", lastSize: 10, elapsed: time.Millisecond, want: 20},
+		{name: "too slow shrinks", lastSize: 10, elapsed: 125 * time.Millisecond, want: 8},
+		{name: "shrink capped at half", lastSize: 10, elapsed: time.Second, want: 5},
+		{name: "min size", lastSize: 3, elapsed: time.Second, want: 2},
+		{name: "max size", lastSize: 40, elapsed: 10 * time.Millisecond, want: 50},
+		{name: "zero elapsed doubles", lastSize: 4, elapsed: 0, want: 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.NextSize(tt.lastSize, tt.elapsed); got != tt.want {
+				t.Errorf("NextSize(%d, %v) = %d, want %d", tt.lastSize, tt.elapsed, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForEachChunk_Sizer(t *testing.T) {
+	input := make([]int, 20)
+	for i := range input {
+		input[i] = i
+	}
+	grow := slice.SizerFunc(func(lastSize int, _ time.Duration) int { return lastSize + 1 })
+
+	t.Run("sequential", func(t *testing.T) {
+		var sizes []int
+		var seen []int
+		err := slice.ForEachChunk(input, 2, 1, func(chunk []int) error {
+			sizes = append(sizes, len(chunk))
+			seen = append(seen, chunk...)
+			return nil
+		}, slice.WithSizer(grow))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !slicesEqual(sizes, []int{2, 3, 4, 5, 6}) {
+			t.Errorf("chunk sizes = %v, want [2 3 4 5 6]", sizes)
+		}
+		if !slicesEqual(seen, input) {
+			t.Errorf("processed %v, want %v", seen, input)
+		}
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		var mu sync.Mutex
+		var seen []int
+		err := slice.ForEachChunk(input, 1, 4, func(chunk []int) error {
+			mu.Lock()
+			defer mu.Unlock()
+			seen = append(seen, chunk...)
+			return nil
+		}, slice.WithSizer(grow))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		sort.Ints(seen)
+		if !slicesEqual(seen, input) {
+			t.Errorf("processed %v, want every element once", seen)
+		}
+	})
+
+	t.Run("error stops sequential", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		calls := 0
+		err := slice.ForEachChunk(input, 2, 1, func(chunk []int) error {
+			calls++
+			return errBoom
+		}, slice.WithLatencyTarget(time.Millisecond))
+		if !errors.Is(err, errBoom) || calls != 1 {
+			t.Errorf("err = %v, calls = %d", err, calls)
+		}
+	})
+
+	t.Run("panic concurrent", func(t *testing.T) {
+		err := slice.ForEachChunk(input, 2, 3, func(chunk []int) error {
+			if chunk[0] == 0 {
+				panic("boom")
+			}
+			return nil
+		}, slice.WithSizer(grow))
+		var panicErr *slice.PanicError
+		if !errors.As(err, &panicErr) || panicErr.Index != 0 {
+			t.Errorf("expected PanicError for chunk 0, got %v", err)
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package slice
 
 import (
+	"context"
 	"sync"
 )
 
@@ -24,9 +25,13 @@ type collectorContextImpl[In, Out any] struct {
 	sliceGetter  func() []In
 	elemGetter   func(index int) In
 	resultGetter func() []Out
+	inputSize    int
+	metadata     map[string]any
+	ctx          context.Context
 	// signals
 	continued      bool
 	stopped        bool
+	stopReason     StopReason
 	errOnStopped   []*ElemError[In]
 	errOnContinued []*ElemError[In]
 	// current state
@@ -56,6 +61,24 @@ func (c *collectorContextImpl[In, Out]) CurrentElem() (int, In) {
 	return c.currentIndex, c.elemGetter(c.currentIndex)
 }
 
+// PrevElem implements the PrevElem method of CollectorContext.
+func (c *collectorContextImpl[In, Out]) PrevElem() (int, In, bool) {
+	if c.currentIndex == 0 {
+		var zero In
+		return -1, zero, false
+	}
+	return c.currentIndex - 1, c.elemGetter(c.currentIndex - 1), true
+}
+
+// PeekNext implements the PeekNext method of CollectorContext.
+func (c *collectorContextImpl[In, Out]) PeekNext() (In, bool) {
+	if c.currentIndex+1 >= c.inputSize {
+		var zero In
+		return zero, false
+	}
+	return c.elemGetter(c.currentIndex + 1), true
+}
+
 // SetValue implements the SetValue method of CollectorContext.
 func (c *collectorContextImpl[In, Out]) SetValue(value Out) {
 	c.currentValue = value
@@ -67,6 +90,20 @@ func (c *collectorContextImpl[In, Out]) CurrentResult() []Out {
 	return c.resultGetter()
 }
 
+// Set implements the Set method of CollectorContext.
+func (c *collectorContextImpl[In, Out]) Set(key string, value any) {
+	if c.metadata == nil {
+		c.metadata = make(map[string]any)
+	}
+	c.metadata[key] = value
+}
+
+// Get implements the Get method of CollectorContext.
+func (c *collectorContextImpl[In, Out]) Get(key string) (any, bool) {
+	value, ok := c.metadata[key]
+	return value, ok
+}
+
 // Continue implements the Continue method of CollectorContext.
 func (c *collectorContextImpl[In, Out]) Continue(errs ...error) {
 	for _, err := range errs {
@@ -84,6 +121,11 @@ func (c *collectorContextImpl[In, Out]) Continue(errs ...error) {
 
 // Stop implements the Stop method of CollectorContext.
 func (c *collectorContextImpl[In, Out]) Stop(errs ...error) {
+	c.StopWith(StopNone, errs...)
+}
+
+// StopWith implements the StopWith method of CollectorContext.
+func (c *collectorContextImpl[In, Out]) StopWith(reason StopReason, errs ...error) {
 	for _, err := range errs {
 		if err != nil {
 			c.errOnStopped = append(c.errOnStopped, &ElemError[In]{
@@ -93,50 +135,91 @@ func (c *collectorContextImpl[In, Out]) Stop(errs ...error) {
 			})
 		}
 	}
+	if reason == StopNone {
+		reason = StopCompleted
+		if len(c.errOnStopped) > 0 {
+			reason = StopFailed
+		}
+	}
+	c.stopReason = reason
 	c.stopped = true
 	panic(sigStop)
 }
 
+// Context implements the Context method of CollectorContext.
+func (c *collectorContextImpl[In, Out]) Context() context.Context {
+	return c.ctx
+}
+
+// invoke runs the handler call fn, translating Stop and Continue signals into state.
+func (c *collectorContextImpl[In, Out]) invoke(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			if s, ok := r.(controlSignal); ok {
+				if s == sigStop {
+					c.stopped = true
+					return
+				}
+				if s == sigContinue {
+					c.continued = true
+					return
+				}
+			}
+			panic(r)
+		}
+	}()
+	fn()
+}
+
 // CollectorContext is a context interface for collection operations.
 type CollectorContext[In, Out any] interface {
 	// Slice returns a copy of the original input slice.
 	Slice() []In
 	// CurrentElem returns the index and value of the current element being processed.
 	CurrentElem() (int, In)
+	// PrevElem returns the index and value of the previous element, and false if
+	// the current element is the first one.
+	PrevElem() (int, In, bool)
+	// PeekNext returns the value of the next element without advancing, and false if
+	// the current element is the last one.
+	PeekNext() (In, bool)
 	// SetValue sets the value to be added to the result.
 	SetValue(value Out)
 	// CurrentResult returns a copy of the current result slice.
 	CurrentResult() []Out
 	// Continue signals to skip adding the current element to the result.
 	Continue(errs ...error)
-	// Stop signals to terminate the collection process immediately.
+	// Stop signals to terminate the collection process immediately. The run is reported
+	// as StopFailed if any error is given, and as StopCompleted otherwise.
 	Stop(errs ...error)
+	// StopWith is like Stop, but records reason as the cause of stopping.
+	// StopNone is treated like Stop.
+	StopWith(reason StopReason, errs ...error)
+	// Context returns the context of the run; it is context.Background for Collect.
+	Context() context.Context
 	// Size returns the size of the original input slice.
 	Size() int
 	// CurrentSize returns the size of the current result slice.
 	CurrentSize() int
+	// Set stores a value under key. Values persist across elements for the whole Collect run.
+	Set(key string, value any)
+	// Get returns the value stored under key by Set, and false if there is none.
+	Get(key string) (any, bool)
 }
 
-// Collect applies a collection operation on the input slice based on the provided context,
-// and returns an error if the handler fails.
-func Collect[In, Out any](input []In, handler func(c CollectorContext[In, Out])) ([]Out, error) {
-	var (
-		result []Out
-		errs   SliceError[In]
-	)
-	if len(input) == 0 || handler == nil {
-		return result, nil
-	}
-
-	// construct context.
+// newCollectorContext creates a context over input. The caller must set resultGetter.
+func newCollectorContext[In, Out any](input []In) *collectorContextImpl[In, Out] {
 	c := &collectorContextImpl[In, Out]{
 		copiedOnce:  &sync.Once{},
 		copied:      nil,
 		sliceGetter: nil,
 		elemGetter:  nil,
+		inputSize:   len(input),
+		ctx:         context.Background(),
 
 		continued:      false,
 		stopped:        false,
+		stopReason:     StopNone,
 		errOnStopped:   nil,
 		errOnContinued: nil,
 
@@ -157,6 +240,35 @@ func Collect[In, Out any](input []In, handler func(c CollectorContext[In, Out]))
 	c.elemGetter = func(index int) In {
 		return input[index]
 	}
+	return c
+}
+
+// Collect applies a collection operation on the input slice based on the provided context,
+// and returns an error if the handler fails.
+// Pass WithObserver to instrument the run.
+func Collect[In, Out any](input []In, handler func(c CollectorContext[In, Out]), opts ...Option) ([]Out, error) {
+	result, _, err := collect(context.Background(), input, handler, opts, nil)
+	return result, err
+}
+
+// collect implements Collect, CollectContext and CollectWithStats.
+// If run is not nil, it is filled in as elements are handled.
+func collect[In, Out any](ctx context.Context, input []In, handler func(c CollectorContext[In, Out]), opts []Option, run *CollectStats) ([]Out, CollectResult, error) {
+	var (
+		result []Out
+		errs   SliceError[In]
+		meta   = CollectResult{Reason: StopNone, StopIndex: -1}
+	)
+	if len(input) == 0 || handler == nil {
+		return result, meta, nil
+	}
+	var stats OpStats
+	defer applyOptions(opts).observe("Collect", len(input), &stats)()
+	defer func() { stats.Errors = len(errs) }()
+
+	// construct context.
+	c := newCollectorContext[In, Out](input)
+	c.ctx = ctx
 	c.resultGetter = func() []Out {
 		if len(result) == 0 {
 			return nil
@@ -167,8 +279,15 @@ func Collect[In, Out any](input []In, handler func(c CollectorContext[In, Out]))
 	}
 
 	for i := range input {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, &ElemError[In]{Index: i, Value: input[i], Err: err})
+			meta = CollectResult{Reason: StopCancelled, StopIndex: i}
+			break
+		}
+
 		c.stopped = false
 		c.continued = false
+		c.stopReason = StopNone
 		c.errOnStopped = nil
 		c.errOnContinued = nil
 		c.hasValue = false
@@ -179,29 +298,19 @@ func Collect[In, Out any](input []In, handler func(c CollectorContext[In, Out]))
 
 		c.currentIndex = i
 
-		func() {
-			defer func() {
-				if r := recover(); r != nil {
-					if s, ok := r.(controlSignal); ok {
-						if s == sigStop {
-							c.stopped = true
-							return
-						}
-						if s == sigContinue {
-							c.continued = true
-							return
-						}
-					}
-					panic(r)
-				}
-			}()
-			handler(c)
-		}()
+		c.invoke(func() { handler(c) })
+		if run != nil {
+			run.Processed++
+			if c.stopped || c.continued || !c.hasValue {
+				run.Skipped++
+			}
+		}
 
 		if c.stopped {
 			if len(c.errOnStopped) > 0 {
 				errs = append(errs, c.errOnStopped...)
 			}
+			meta = CollectResult{Reason: c.stopReason, StopIndex: i}
 			break
 		}
 		if c.continued {
@@ -216,9 +325,42 @@ func Collect[In, Out any](input []In, handler func(c CollectorContext[In, Out]))
 		}
 	}
 	if len(errs) == 0 {
-		return result, nil
+		return result, meta, nil
+	}
+	return result, meta, errs
+}
+
+// ForEachErr calls fn for every element of the slice and records every failure.
+// It returns a SliceError with the index, value and error of each failing element,
+// or nil if fn succeeded for all elements.
+func ForEachErr[In any](input []In, fn func(i int, item In) error) error {
+	return forEachErr(input, false, fn)
+}
+
+// ForEachErrFast calls fn for every element of the slice, stopping at the first failure.
+// It returns a SliceError holding the failing element, or nil if fn succeeded for all elements.
+func ForEachErrFast[In any](input []In, fn func(i int, item In) error) error {
+	return forEachErr(input, true, fn)
+}
+
+// forEachErr implements ForEachErr and ForEachErrFast.
+func forEachErr[In any](input []In, failFast bool, fn func(i int, item In) error) error {
+	if len(input) == 0 || fn == nil {
+		return nil
+	}
+	var errs SliceError[In]
+	for i, item := range input {
+		if err := fn(i, item); err != nil {
+			errs = append(errs, &ElemError[In]{Index: i, Value: item, Err: err})
+			if failFast {
+				break
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
 	}
-	return result, errs
+	return errs
 }
 
 // Filter applies a filtering operation on the input slice based on the provided predicate function.
@@ -248,6 +390,24 @@ func Reduce[In, Out any](input []In, reducer func(Out, In) Out, initial Out) Out
 	return acc
 }
 
+// ReduceErr is like Reduce, but the reducer can fail. It stops at the first error and
+// returns the accumulator as it was before the failing element, together with an
+// *ElemError carrying the index and value of that element.
+func ReduceErr[In, Out any](input []In, reducer func(acc Out, item In) (Out, error), initial Out) (Out, error) {
+	acc := initial
+	if reducer == nil {
+		return acc, nil
+	}
+	for i, item := range input {
+		next, err := reducer(acc, item)
+		if err != nil {
+			return acc, &ElemError[In]{Index: i, Value: item, Err: err}
+		}
+		acc = next
+	}
+	return acc, nil
+}
+
 // Every returns true if all elements in the slice satisfy the predicate.
 // Returns true for empty slices (vacuously true).
 func Every[In any](input []In, predicate func(item In) bool) bool {
@@ -276,6 +436,26 @@ func Some[In any](input []In, predicate func(item In) bool) bool {
 	return false
 }
 
+// None returns true if no element in the slice satisfies the predicate.
+// Returns true for empty slices.
+func None[In any](input []In, predicate func(item In) bool) bool {
+	return !Some(input, predicate)
+}
+
+// Count returns the number of elements in the slice that satisfy the predicate.
+func Count[In any](input []In, predicate func(item In) bool) int {
+	if predicate == nil {
+		return 0
+	}
+	count := 0
+	for _, item := range input {
+		if predicate(item) {
+			count++
+		}
+	}
+	return count
+}
+
 // Map applies a transformation function to each element of the slice and returns a new slice.
 func Map[In, Out any](input []In, mapper func(item In) Out) []Out {
 	if len(input) == 0 || mapper == nil {
@@ -288,6 +468,34 @@ func Map[In, Out any](input []In, mapper func(item In) Out) []Out {
 	return result
 }
 
+// MapIndexed applies a transformation function to each element of the slice, along with
+// its index, and returns a new slice.
+func MapIndexed[In, Out any](input []In, mapper func(i int, item In) Out) []Out {
+	if len(input) == 0 || mapper == nil {
+		return nil
+	}
+	result := make([]Out, len(input))
+	for i, item := range input {
+		result[i] = mapper(i, item)
+	}
+	return result
+}
+
+// FilterIndexed returns a new slice containing the elements that satisfy the predicate,
+// which receives each element along with its index.
+func FilterIndexed[In any](input []In, predicate func(i int, item In) bool) []In {
+	if len(input) == 0 || predicate == nil {
+		return input
+	}
+	var result []In
+	for i, item := range input {
+		if predicate(i, item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
 // Find returns the first element that satisfies the predicate and true.
 // If no element matches, it returns the zero value and false.
 func Find[In any](input []In, predicate func(item In) bool) (In, bool) {
@@ -303,6 +511,40 @@ func Find[In any](input []In, predicate func(item In) bool) (In, bool) {
 	return zero, false
 }
 
+// LastWhere returns the last element that satisfies the predicate and true.
+// If no element matches, or the predicate is nil, it returns the zero value and false.
+func LastWhere[In any](input []In, predicate func(item In) bool) (In, bool) {
+	var zero In
+	if len(input) == 0 || predicate == nil {
+		return zero, false
+	}
+	for i := len(input) - 1; i >= 0; i-- {
+		if predicate(input[i]) {
+			return input[i], true
+		}
+	}
+	return zero, false
+}
+
+// FirstN returns up to n elements that satisfy the predicate, in order, and stops
+// scanning as soon as n have been found.
+// Returns nil if n <= 0, the predicate is nil, or no element matches.
+func FirstN[In any](input []In, n int, predicate func(item In) bool) []In {
+	if n <= 0 || len(input) == 0 || predicate == nil {
+		return nil
+	}
+	var result []In
+	for _, item := range input {
+		if predicate(item) {
+			result = append(result, item)
+			if len(result) == n {
+				break
+			}
+		}
+	}
+	return result
+}
+
 // Contains returns true if the slice contains the target element.
 // In must be comparable.
 func Contains[In comparable](input []In, target In) bool {
@@ -340,22 +582,88 @@ func Chunk[In any](input []In, size int) [][]In {
 	return chunks
 }
 
+// SplitN splits a slice into n parts whose sizes differ by at most one, with the larger
+// parts first, e.g. to hand one part to each of n workers. If n > len(input), it
+// returns len(input) parts of one element, so no part is empty.
+// Returns nil if the input slice is nil. If n is <= 0, it defaults to 1.
+func SplitN[In any](input []In, n int) [][]In {
+	if input == nil {
+		return nil
+	}
+	if len(input) == 0 {
+		return make([][]In, 0)
+	}
+	n = min(max(n, 1), len(input))
+
+	size, extra := len(input)/n, len(input)%n
+	parts := make([][]In, 0, n)
+	for i := range n {
+		end := size
+		if i < extra {
+			end++
+		}
+		input, parts = input[end:], append(parts, input[0:end:end])
+	}
+	return parts
+}
+
+// ChunkBy splits a slice into chunks of consecutive elements, starting a new chunk
+// whenever boundary reports true for a pair of adjacent elements.
+// Returns nil if the input slice is nil.
+// If boundary is nil, the whole slice is returned as a single chunk.
+func ChunkBy[In any](input []In, boundary func(prev, cur In) bool) [][]In {
+	if input == nil {
+		return nil
+	}
+	if len(input) == 0 {
+		return make([][]In, 0)
+	}
+	if boundary == nil {
+		return [][]In{input}
+	}
+
+	var (
+		chunks [][]In
+		start  int
+	)
+	for i := 1; i < len(input); i++ {
+		if boundary(input[i-1], input[i]) {
+			chunks = append(chunks, input[start:i:i])
+			start = i
+		}
+	}
+	chunks = append(chunks, input[start:])
+	return chunks
+}
+
 // ForEachChunk splits the slice into chunks and processes them using the handler.
 // The concurrency parameter controls the number of concurrent handlers.
 // If concurrency <= 1, chunks are processed sequentially.
 // If any handler returns an error, the function returns the first error encountered.
+// A panicking handler is recovered and reported as a *PanicError carrying the chunk index
+// and stack trace; pass WithRepanic to propagate the panic to the caller instead.
+// Pass WithSizer or WithLatencyTarget to adapt the chunk size as processing goes,
+// starting from chunkSize.
 // Note: When running concurrently, the order of execution is not guaranteed,
 // and it will wait for all started goroutines to finish even if one fails.
-func ForEachChunk[In any](input []In, chunkSize int, concurrency int, handler func(chunk []In) error) error {
+func ForEachChunk[In any](input []In, chunkSize int, concurrency int, handler func(chunk []In) error, opts ...Option) error {
 	if len(input) == 0 {
 		return nil
 	}
+	o := applyOptions(opts)
+	var stats OpStats
+	defer o.observe("ForEachChunk", len(input), &stats)()
+	if o.sizer != nil {
+		return forEachAdaptiveChunk(input, chunkSize, concurrency, handler, o, &stats)
+	}
 	chunks := Chunk(input, chunkSize)
 
 	if concurrency <= 1 {
-		for _, chunk := range chunks {
-			if err := handler(chunk); err != nil {
-				return err
+		for i, chunk := range chunks {
+			stats.Chunks++
+			if err := safeCall(i, func() error { return handler(chunk) }); err != nil {
+				stats.Errors++
+				return o.handleError(err)
 			}
 		}
 		return nil
@@ -367,26 +675,33 @@ func ForEachChunk[In any](input []In, chunkSize int, concurrency int, handler fu
 		sem     = make(chan struct{}, concurrency)
 	)
 
-	for _, chunk := range chunks {
+	for i, chunk := range chunks {
 		sem <- struct{}{} // Acquire token
 		wg.Add(1)
-		go func(c []In) {
+		go func(i int, c []In) {
 			defer wg.Done()
 			defer func() { <-sem }() // Release token
-			if err := handler(c); err != nil {
+			if err := safeCall(i, func() error { return handler(c) }); err != nil {
 				errChan <- err
 			}
-		}(chunk)
+		}(i, chunk)
 	}
 
 	wg.Wait()
 	close(errChan)
+	stats.Chunks, stats.Errors = len(chunks), len(errChan)
 
-	// Return the first error if any
-	if len(errChan) > 0 {
-		return <-errChan
+	// Return the first error if any, surfacing panics first when re-panicking
+	var first error
+	for err := range errChan {
+		if first == nil {
+			first = err
+		}
+		if _, ok := err.(*PanicError); ok && o.repanic {
+			panic(err)
+		}
 	}
-	return nil
+	return first
 }
 
 // Flatten flattens a slice of slices into a single slice.
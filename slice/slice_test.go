@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"testing"
 )
 
@@ -209,6 +210,27 @@ func TestReduce(t *testing.T) {
 	}
 }
 
+func TestReduceErr(t *testing.T) {
+	sum := func(acc int, item string) (int, error) {
+		n, err := strconv.Atoi(item)
+		return acc + n, err
+	}
+
+	res, err := ReduceErr([]string{"1", "2", "3"}, sum, 10)
+	if res != 16 || err != nil {
+		t.Errorf("Expected 16, nil, got %v, %v", res, err)
+	}
+
+	res, err = ReduceErr([]string{"1", "x", "3"}, sum, 0)
+	if res != 1 {
+		t.Errorf("Expected accumulator before the failure, got %v", res)
+	}
+	var elemErr *ElemError[string]
+	if !errors.As(err, &elemErr) || elemErr.Index != 1 || elemErr.Value != "x" {
+		t.Errorf("Expected ElemError at index 1, got %v", err)
+	}
+}
+
 func TestEvery(t *testing.T) {
 	if !Every([]int{2, 4, 6}, func(i int) bool { return i%2 == 0 }) {
 		t.Error("Expected Every to return true for all even numbers")
@@ -233,6 +255,27 @@ func TestSome(t *testing.T) {
 	}
 }
 
+func TestNone(t *testing.T) {
+	if !None([]int{1, 3, 5}, func(i int) bool { return i%2 == 0 }) {
+		t.Error("Expected None to return true if no even numbers exist")
+	}
+	if None([]int{1, 2, 5}, func(i int) bool { return i%2 == 0 }) {
+		t.Error("Expected None to return false if one even number exists")
+	}
+	if !None([]int{}, func(i int) bool { return true }) {
+		t.Error("Expected None to return true for empty slice")
+	}
+}
+
+func TestCount(t *testing.T) {
+	if got := Count([]int{1, 2, 3, 4}, func(i int) bool { return i%2 == 0 }); got != 2 {
+		t.Errorf("Expected 2, got %v", got)
+	}
+	if got := Count([]int{}, func(i int) bool { return true }); got != 0 {
+		t.Errorf("Expected 0, got %v", got)
+	}
+}
+
 func TestMap(t *testing.T) {
 	input := []int{1, 2, 3}
 	res := Map(input, func(i int) string {
@@ -247,6 +290,31 @@ func TestMap(t *testing.T) {
 	}
 }
 
+func TestMapIndexed(t *testing.T) {
+	input := []string{"a", "b", "c"}
+	res := MapIndexed(input, func(i int, s string) string {
+		return fmt.Sprintf("%d:%s", i, s)
+	})
+	expected := []string{"0:a", "1:b", "2:c"}
+	if !reflect.DeepEqual(res, expected) {
+		t.Errorf("Expected %v, got %v", expected, res)
+	}
+	if MapIndexed[int, int](nil, nil) != nil {
+		t.Error("Expected nil result for nil input")
+	}
+}
+
+func TestFilterIndexed(t *testing.T) {
+	input := []string{"a", "b", "c", "d", "e"}
+	res := FilterIndexed(input, func(i int, _ string) bool {
+		return i%2 == 0
+	})
+	expected := []string{"a", "c", "e"}
+	if !reflect.DeepEqual(res, expected) {
+		t.Errorf("Expected %v, got %v", expected, res)
+	}
+}
+
 func TestFind(t *testing.T) {
 	input := []int{1, 2, 3, 4}
 	val, found := Find(input, func(i int) bool { return i%2 == 0 })
@@ -260,6 +328,61 @@ func TestFind(t *testing.T) {
 	}
 }
 
+func TestLastWhere(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5}
+
+	val, found := LastWhere(input, func(v int) bool { return v%2 == 0 })
+	if !found || val != 4 {
+		t.Errorf("Expected to find 4, got %v found=%v", val, found)
+	}
+
+	val, found = LastWhere(input, func(v int) bool { return v > 10 })
+	if found || val != 0 {
+		t.Errorf("Expected not found, got %v found=%v", val, found)
+	}
+
+	val, found = LastWhere(input, nil)
+	if found || val != 0 {
+		t.Errorf("Expected not found for nil predicate, got %v found=%v", val, found)
+	}
+}
+
+func TestFirstN(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	even := func(v int) bool { return v%2 == 0 }
+
+	tests := []struct {
+		name      string
+		input     []int
+		n         int
+		predicate func(int) bool
+		expected  []int
+	}{
+		{name: "fewer than available", input: input, n: 2, predicate: even, expected: []int{2, 4}},
+		{name: "more than available", input: input, n: 10, predicate: even, expected: []int{2, 4, 6, 8}},
+		{name: "zero", input: input, n: 0, predicate: even, expected: nil},
+		{name: "negative", input: input, n: -1, predicate: even, expected: nil},
+		{name: "nil predicate", input: input, n: 2, predicate: nil, expected: nil},
+		{name: "no match", input: []int{1, 3}, n: 2, predicate: even, expected: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FirstN(tt.input, tt.n, tt.predicate); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+
+	scanned := 0
+	FirstN(input, 1, func(v int) bool {
+		scanned++
+		return v > 2
+	})
+	if scanned != 3 {
+		t.Errorf("Expected scanning to stop after 3 elements, scanned %d", scanned)
+	}
+}
+
 func TestContains(t *testing.T) {
 	input := []int{1, 2, 3}
 	if !Contains(input, 2) {
@@ -272,3 +395,122 @@ func TestContains(t *testing.T) {
 		t.Error("Expected Contains to return false for nil input")
 	}
 }
+
+func TestCollect_PrevElem(t *testing.T) {
+	input := []int{1, 1, 2, 3, 3, 3, 4}
+	res, err := Collect(input, func(c CollectorContext[int, int]) {
+		_, val := c.CurrentElem()
+		if _, prev, ok := c.PrevElem(); ok && prev == val {
+			c.Continue()
+		}
+		c.SetValue(val)
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	expected := []int{1, 2, 3, 4}
+	if !reflect.DeepEqual(res, expected) {
+		t.Errorf("Expected %v, got %v", expected, res)
+	}
+
+	_, _ = Collect([]int{10}, func(c CollectorContext[int, int]) {
+		idx, val, ok := c.PrevElem()
+		if ok || idx != -1 || val != 0 {
+			t.Errorf("Expected no previous element, got (%d, %d, %v)", idx, val, ok)
+		}
+	})
+}
+
+func TestCollect_PeekNext(t *testing.T) {
+	input := []int{1, 4, 9, 16}
+	res, err := Collect(input, func(c CollectorContext[int, int]) {
+		_, val := c.CurrentElem()
+		next, ok := c.PeekNext()
+		if !ok {
+			c.Stop()
+		}
+		c.SetValue(next - val)
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	expected := []int{3, 5, 7}
+	if !reflect.DeepEqual(res, expected) {
+		t.Errorf("Expected %v, got %v", expected, res)
+	}
+}
+
+func TestForEachErr(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	errOdd := errors.New("odd")
+	visited := 0
+
+	err := ForEachErr(input, func(i int, item int) error {
+		visited++
+		if item%2 != 0 {
+			return errOdd
+		}
+		return nil
+	})
+	if visited != 4 {
+		t.Errorf("Expected 4 visited elements, got %d", visited)
+	}
+	var sliceErr SliceError[int]
+	if !errors.As(err, &sliceErr) {
+		t.Fatalf("Expected SliceError, got %T", err)
+	}
+	if len(sliceErr) != 2 || sliceErr[0].Index != 0 || sliceErr[1].Index != 2 || sliceErr[1].Value != 3 {
+		t.Errorf("Expected failures at indexes 0 and 2, got %+v", sliceErr)
+	}
+	if !errors.Is(err, errOdd) {
+		t.Errorf("Expected error to contain %v", errOdd)
+	}
+
+	if err := ForEachErr(input, func(int, int) error { return nil }); err != nil {
+		t.Errorf("Expected nil error, got %v", err)
+	}
+}
+
+func TestForEachErrFast(t *testing.T) {
+	input := []int{2, 3, 4, 5}
+	visited := 0
+
+	err := ForEachErrFast(input, func(i int, item int) error {
+		visited++
+		if item%2 != 0 {
+			return fmt.Errorf("odd %d", item)
+		}
+		return nil
+	})
+	if visited != 2 {
+		t.Errorf("Expected to stop after 2 elements, got %d", visited)
+	}
+	var sliceErr SliceError[int]
+	if !errors.As(err, &sliceErr) || len(sliceErr) != 1 || sliceErr[0].Index != 1 {
+		t.Errorf("Expected single failure at index 1, got %v", err)
+	}
+}
+
+func TestCollect_Metadata(t *testing.T) {
+	input := []int{1, 5, 6, 20, 21}
+	res, err := Collect(input, func(c CollectorContext[int, []int]) {
+		_, val := c.CurrentElem()
+		if last, ok := c.Get("last"); ok && val-last.(int) > 3 {
+			c.SetValue([]int{last.(int), val})
+		}
+		c.Set("last", val)
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	expected := [][]int{{1, 5}, {6, 20}}
+	if !reflect.DeepEqual(res, expected) {
+		t.Errorf("Expected %v, got %v", expected, res)
+	}
+
+	_, _ = Collect([]int{1}, func(c CollectorContext[int, int]) {
+		if _, ok := c.Get("last"); ok {
+			t.Error("Expected metadata not to leak between Collect runs")
+		}
+	})
+}
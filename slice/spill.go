@@ -0,0 +1,284 @@
+package slice
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+	"sync"
+)
+
+// Codec serializes chunks for a Spool. Encode writes one chunk to w, and Decode reads
+// back exactly what Encode wrote. Codecs need not be self-delimiting.
+type Codec[T any] interface {
+	Encode(w io.Writer, chunk []T) error
+	Decode(r io.Reader) ([]T, error)
+}
+
+type gobCodec[T any] struct{}
+
+// GobCodec returns a Codec that serializes chunks with encoding/gob.
+func GobCodec[T any]() Codec[T] {
+	return gobCodec[T]{}
+}
+
+// Encode implements Codec.
+func (gobCodec[T]) Encode(w io.Writer, chunk []T) error {
+	return gob.NewEncoder(w).Encode(chunk)
+}
+
+// Decode implements Codec.
+func (gobCodec[T]) Decode(r io.Reader) ([]T, error) {
+	var chunk []T
+	err := gob.NewDecoder(r).Decode(&chunk)
+	return chunk, err
+}
+
+// Spool stores chunks in append order, keeping them in memory until a budget of
+// elements is reached and serializing the rest to a temporary file with a Codec.
+// It lets chunked pipelines handle inputs larger than available memory.
+// A Spool is not safe for concurrent use, and must be closed to remove its file.
+type Spool[T any] struct {
+	budget  int
+	codec   Codec[T]
+	dir     string
+	memory  [][]T
+	inMem   int
+	spilled int
+	elems   int
+	file    *os.File
+	w       *bufio.Writer
+	scratch bytes.Buffer
+}
+
+// NewSpool creates a Spool that keeps at most budget elements in memory and spills
+// later chunks with codec. A budget <= 0 spills every chunk.
+func NewSpool[T any](budget int, codec Codec[T]) *Spool[T] {
+	return &Spool[T]{budget: max(budget, 0), codec: codec}
+}
+
+// WithDir sets the directory for the spill file. By default os.TempDir is used.
+func (s *Spool[T]) WithDir(dir string) *Spool[T] {
+	s.dir = dir
+	return s
+}
+
+// Append adds a copy of chunk to the spool. Once a chunk has been spilled, all later
+// chunks are spilled too, so that chunks are read back in append order.
+func (s *Spool[T]) Append(chunk []T) error {
+	if len(chunk) == 0 {
+		return nil
+	}
+	if s.spilled == 0 && s.inMem+len(chunk) <= s.budget {
+		s.memory = append(s.memory, append([]T(nil), chunk...))
+		s.inMem += len(chunk)
+		s.elems += len(chunk)
+		return nil
+	}
+	if err := s.spill(chunk); err != nil {
+		return err
+	}
+	s.spilled++
+	s.elems += len(chunk)
+	return nil
+}
+
+// AppendSeq reads input into the spool in chunks of chunkSize elements.
+func (s *Spool[T]) AppendSeq(input iter.Seq[T], chunkSize int) error {
+	chunkSize = max(chunkSize, 1)
+	buf := make([]T, 0, chunkSize)
+	for item := range input {
+		buf = append(buf, item)
+		if len(buf) == chunkSize {
+			if err := s.Append(buf); err != nil {
+				return err
+			}
+			buf = buf[:0]
+		}
+	}
+	return s.Append(buf)
+}
+
+// spill writes chunk to the spill file as a length-prefixed frame.
+func (s *Spool[T]) spill(chunk []T) error {
+	if s.file == nil {
+		f, err := os.CreateTemp(s.dir, "devutil-spool-*")
+		if err != nil {
+			return fmt.Errorf("slice: create spill file: %w", err)
+		}
+		s.file, s.w = f, bufio.NewWriter(f)
+	}
+	s.scratch.Reset()
+	if err := s.codec.Encode(&s.scratch, chunk); err != nil {
+		return fmt.Errorf("slice: encode chunk: %w", err)
+	}
+	var size [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(size[:], uint64(s.scratch.Len()))
+	if _, err := s.w.Write(size[:n]); err != nil {
+		return fmt.Errorf("slice: write spill file: %w", err)
+	}
+	if _, err := s.w.Write(s.scratch.Bytes()); err != nil {
+		return fmt.Errorf("slice: write spill file: %w", err)
+	}
+	return nil
+}
+
+// Len returns the number of elements in the spool.
+func (s *Spool[T]) Len() int {
+	return s.elems
+}
+
+// Chunks returns the number of chunks in the spool.
+func (s *Spool[T]) Chunks() int {
+	return len(s.memory) + s.spilled
+}
+
+// Spilled returns the number of chunks written to disk.
+func (s *Spool[T]) Spilled() int {
+	return s.spilled
+}
+
+// All returns an iterator over the chunks in append order, reading spilled chunks back
+// from disk one at a time. If reading fails, the error is yielded with a nil chunk and
+// iteration stops. Chunks kept in memory are shared with the spool and must not be modified.
+func (s *Spool[T]) All() iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		for _, chunk := range s.memory {
+			if !yield(chunk, nil) {
+				return
+			}
+		}
+		if s.spilled == 0 {
+			return
+		}
+		if err := s.w.Flush(); err != nil {
+			yield(nil, fmt.Errorf("slice: write spill file: %w", err))
+			return
+		}
+		f, err := os.Open(s.file.Name())
+		if err != nil {
+			yield(nil, fmt.Errorf("slice: open spill file: %w", err))
+			return
+		}
+		defer f.Close()
+
+		r := bufio.NewReader(f)
+		for range s.spilled {
+			chunk, err := s.readChunk(r)
+			if !yield(chunk, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// readChunk reads one length-prefixed frame from r and decodes it.
+func (s *Spool[T]) readChunk(r *bufio.Reader) ([]T, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, fmt.Errorf("slice: read spill file: %w", err)
+	}
+	frame := io.LimitReader(r, int64(size))
+	chunk, err := s.codec.Decode(frame)
+	if err != nil {
+		return nil, fmt.Errorf("slice: decode chunk: %w", err)
+	}
+	// Skip anything the codec did not consume, to stay aligned on the next frame.
+	if _, err := io.Copy(io.Discard, frame); err != nil {
+		return nil, fmt.Errorf("slice: read spill file: %w", err)
+	}
+	return chunk, nil
+}
+
+// ForEachChunk processes every chunk in the spool with the handler, with the same
+// concurrency, panic and error semantics as the package-level ForEachChunk.
+// Spilled chunks are read back one at a time as handlers become available, so at
+// most concurrency spilled chunks are in memory at once. A read error stops
+// dispatching, waits for started handlers and is returned.
+func (s *Spool[T]) ForEachChunk(concurrency int, handler func(chunk []T) error, opts ...Option) error {
+	o := applyOptions(opts)
+	var stats OpStats
+	defer o.observe("Spool.ForEachChunk", s.elems, &stats)()
+
+	if concurrency <= 1 {
+		i := 0
+		for chunk, err := range s.All() {
+			if err != nil {
+				return err
+			}
+			stats.Chunks++
+			if err := safeCall(i, func() error { return handler(chunk) }); err != nil {
+				stats.Errors++
+				return o.handleError(err)
+			}
+			i++
+		}
+		return nil
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		first    error
+		panicErr *PanicError
+		readErr  error
+		sem      = make(chan struct{}, concurrency)
+	)
+	i := 0
+	for chunk, err := range s.All() {
+		if err != nil {
+			readErr = err
+			break
+		}
+		sem <- struct{}{} // Acquire token
+		wg.Add(1)
+		go func(i int, c []T) {
+			defer wg.Done()
+			defer func() { <-sem }() // Release token
+			err := safeCall(i, func() error { return handler(c) })
+			mu.Lock()
+			defer mu.Unlock()
+			stats.Chunks++
+			if err == nil {
+				return
+			}
+			stats.Errors++
+			if first == nil {
+				first = err
+			}
+			if pe, ok := err.(*PanicError); ok && panicErr == nil {
+				panicErr = pe
+			}
+		}(i, chunk)
+		i++
+	}
+	wg.Wait()
+
+	if panicErr != nil && o.repanic {
+		panic(panicErr)
+	}
+	if readErr != nil {
+		return errors.Join(readErr, first)
+	}
+	return first
+}
+
+// Close removes the spill file, if any. The spool must not be used afterwards.
+func (s *Spool[T]) Close() error {
+	s.memory = nil
+	if s.file == nil {
+		return nil
+	}
+	name := s.file.Name()
+	err := s.file.Close()
+	s.file, s.w = nil, nil
+	if rmErr := os.Remove(name); rmErr != nil && err == nil {
+		err = rmErr
+	}
+	return err
+}
@@ -0,0 +1,126 @@
+package slice_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestSpool(t *testing.T) {
+	dir := t.TempDir()
+	spool := slice.NewSpool(5, slice.GobCodec[int]()).WithDir(dir)
+
+	if err := spool.AppendSeq(slices.Values([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}), 3); err != nil {
+		t.Fatalf("AppendSeq() error = %v", err)
+	}
+	if spool.Len() != 11 || spool.Chunks() != 4 || spool.Spilled() != 3 {
+		t.Errorf("Len() = %d, Chunks() = %d, Spilled() = %d, want 11, 4, 3", spool.Len(), spool.Chunks(), spool.Spilled())
+	}
+
+	// Chunks can be read back more than once, in append order.
+	for range 2 {
+		var got [][]int
+		for chunk, err := range spool.All() {
+			if err != nil {
+				t.Fatalf("All() error = %v", err)
+			}
+			got = append(got, chunk)
+		}
+		want := [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}, {10, 11}}
+		if !slicesEqual2D(got, want) {
+			t.Errorf("All() = %v, want %v", got, want)
+		}
+	}
+
+	if err := spool.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("expected spill file to be removed, found %v", entries)
+	}
+}
+
+func TestSpool_AppendCopies(t *testing.T) {
+	spool := slice.NewSpool(10, slice.GobCodec[int]())
+	defer spool.Close()
+
+	chunk := []int{1, 2}
+	_ = spool.Append(chunk)
+	chunk[0] = 99
+	for got := range spool.All() {
+		if got[0] != 1 {
+			t.Errorf("expected Append to copy the chunk, got %v", got)
+		}
+	}
+}
+
+func TestSpool_ForEachChunk(t *testing.T) {
+	for _, concurrency := range []int{1, 4} {
+		spool := slice.NewSpool(0, slice.GobCodec[int]()).WithDir(t.TempDir())
+		input := make([]int, 100)
+		for i := range input {
+			input[i] = i
+		}
+		if err := spool.AppendSeq(slices.Values(input), 7); err != nil {
+			t.Fatalf("AppendSeq() error = %v", err)
+		}
+
+		var sum atomic.Int64
+		err := spool.ForEachChunk(concurrency, func(chunk []int) error {
+			for _, v := range chunk {
+				sum.Add(int64(v))
+			}
+			return nil
+		})
+		if err != nil {
+			t.Errorf("ForEachChunk() error = %v", err)
+		}
+		if sum.Load() != 4950 {
+			t.Errorf("concurrency %d: sum = %d, want 4950", concurrency, sum.Load())
+		}
+		spool.Close()
+	}
+}
+
+func TestSpool_ForEachChunkErrors(t *testing.T) {
+	spool := slice.NewSpool(0, slice.GobCodec[int]()).WithDir(t.TempDir())
+	defer spool.Close()
+	_ = spool.AppendSeq(slices.Values([]int{1, 2, 3, 4}), 2)
+
+	errFail := errors.New("fail")
+	err := spool.ForEachChunk(2, func(chunk []int) error {
+		if chunk[0] == 3 {
+			return errFail
+		}
+		return nil
+	})
+	if !errors.Is(err, errFail) {
+		t.Errorf("expected errFail, got %v", err)
+	}
+
+	err = spool.ForEachChunk(1, func(chunk []int) error { panic("boom") })
+	var panicErr *slice.PanicError
+	if !errors.As(err, &panicErr) {
+		t.Errorf("expected PanicError, got %v", err)
+	}
+}
+
+type failingCodec struct{ slice.Codec[int] }
+
+func (failingCodec) Decode(io.Reader) ([]int, error) { return nil, errors.New("corrupt") }
+
+func TestSpool_DecodeError(t *testing.T) {
+	spool := slice.NewSpool(0, slice.Codec[int](failingCodec{slice.GobCodec[int]()})).WithDir(t.TempDir())
+	defer spool.Close()
+	_ = spool.Append([]int{1})
+
+	err := spool.ForEachChunk(2, func([]int) error { return nil })
+	if err == nil || err.Error() != "slice: decode chunk: corrupt" {
+		t.Errorf("expected decode error, got %v", err)
+	}
+}
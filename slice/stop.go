@@ -0,0 +1,50 @@
+package slice
+
+import "context"
+
+// StopReason tells why a Collect run ended before visiting every element.
+type StopReason int
+
+const (
+	// StopNone means every element was visited.
+	StopNone StopReason = iota
+	// StopCompleted means the handler stopped early because the work was done.
+	StopCompleted
+	// StopCancelled means the run was cancelled, by its context or by the handler.
+	StopCancelled
+	// StopFailed means the handler stopped because of an error.
+	StopFailed
+)
+
+// String returns the name of the reason.
+func (r StopReason) String() string {
+	switch r {
+	case StopNone:
+		return "none"
+	case StopCompleted:
+		return "completed"
+	case StopCancelled:
+		return "cancelled"
+	case StopFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// CollectResult describes how a Collect run ended.
+type CollectResult struct {
+	// Reason is why the run stopped, or StopNone if it visited every element.
+	Reason StopReason
+	// StopIndex is the index of the element at which the run stopped, or -1 if it did not stop.
+	// When the context is cancelled, it is the first element that was not handled.
+	StopIndex int
+}
+
+// CollectContext is like Collect, but checks ctx before each element and also reports
+// how the run ended. If ctx is done, collection stops with StopCancelled and the error
+// includes ctx.Err() for the first unhandled element. The handler can read ctx through
+// CollectorContext.Context.
+func CollectContext[In, Out any](ctx context.Context, input []In, handler func(c CollectorContext[In, Out]), opts ...Option) ([]Out, CollectResult, error) {
+	return collect(ctx, input, handler, opts, nil)
+}
@@ -0,0 +1,105 @@
+package slice_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestCollectContext_StopReasons(t *testing.T) {
+	errBad := errors.New("bad")
+	input := []int{1, 2, 3, 4, 5}
+
+	tests := []struct {
+		name      string
+		handler   func(c slice.CollectorContext[int, int])
+		want      []int
+		reason    slice.StopReason
+		stopIndex int
+		wantErr   bool
+	}{
+		{
+			name: "visits everything",
+			handler: func(c slice.CollectorContext[int, int]) {
+				_, v := c.CurrentElem()
+				c.SetValue(v)
+			},
+			want: input, reason: slice.StopNone, stopIndex: -1,
+		},
+		{
+			name: "completed early",
+			handler: func(c slice.CollectorContext[int, int]) {
+				if c.CurrentSize() == 2 {
+					c.Stop()
+				}
+				_, v := c.CurrentElem()
+				c.SetValue(v)
+			},
+			want: []int{1, 2}, reason: slice.StopCompleted, stopIndex: 2,
+		},
+		{
+			name: "failed",
+			handler: func(c slice.CollectorContext[int, int]) {
+				if _, v := c.CurrentElem(); v == 4 {
+					c.Stop(errBad)
+				}
+			},
+			want: nil, reason: slice.StopFailed, stopIndex: 3, wantErr: true,
+		},
+		{
+			name: "explicit reason",
+			handler: func(c slice.CollectorContext[int, int]) {
+				c.StopWith(slice.StopCancelled)
+			},
+			want: nil, reason: slice.StopCancelled, stopIndex: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, res, err := slice.CollectContext(context.Background(), input, tt.handler)
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("CollectContext() = %v, want %v", got, tt.want)
+			}
+			if res.Reason != tt.reason || res.StopIndex != tt.stopIndex {
+				t.Errorf("CollectContext() result = %+v, want reason %v at %d", res, tt.reason, tt.stopIndex)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CollectContext() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCollectContext_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, res, err := slice.CollectContext(ctx, []int{1, 2, 3, 4}, func(c slice.CollectorContext[int, int]) {
+		_, v := c.CurrentElem()
+		if v == 2 {
+			cancel()
+		}
+		if c.Context() != ctx {
+			t.Error("Context() does not return the run context")
+		}
+		c.SetValue(v)
+	})
+	if !slicesEqual(got, []int{1, 2}) {
+		t.Errorf("CollectContext() = %v, want [1 2]", got)
+	}
+	if res.Reason != slice.StopCancelled || res.StopIndex != 2 {
+		t.Errorf("CollectContext() result = %+v, want cancelled at 2", res)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestStopReason_String(t *testing.T) {
+	if slice.StopFailed.String() != "failed" || slice.StopReason(99).String() != "unknown" {
+		t.Errorf("unexpected StopReason names: %v, %v", slice.StopFailed, slice.StopReason(99))
+	}
+}
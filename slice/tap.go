@@ -0,0 +1,21 @@
+package slice
+
+// Tap calls fn with the whole slice and returns the slice unchanged, so logging or
+// assertions can be inserted between pipeline steps.
+func Tap[In any](input []In, fn func(items []In)) []In {
+	if fn != nil {
+		fn(input)
+	}
+	return input
+}
+
+// Each calls fn for every element with its index and returns the slice unchanged,
+// so per-element logging can be inserted between pipeline steps.
+func Each[In any](input []In, fn func(i int, item In)) []In {
+	if fn != nil {
+		for i, item := range input {
+			fn(i, item)
+		}
+	}
+	return input
+}
@@ -0,0 +1,33 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestTap(t *testing.T) {
+	var seen []int
+	evens := slice.Filter(
+		slice.Tap([]int{1, 2, 3, 4}, func(items []int) { seen = items }),
+		func(v int) bool { return v%2 == 0 },
+	)
+	if !slicesEqual(seen, []int{1, 2, 3, 4}) || !slicesEqual(evens, []int{2, 4}) {
+		t.Errorf("Tap() saw %v, pipeline returned %v", seen, evens)
+	}
+	if got := slice.Tap([]int{1}, nil); !slicesEqual(got, []int{1}) {
+		t.Errorf("Tap(nil fn) = %v", got)
+	}
+}
+
+func TestEach(t *testing.T) {
+	input := []string{"a", "b"}
+	var indexes []int
+	got := slice.Each(input, func(i int, _ string) { indexes = append(indexes, i) })
+	if !slicesEqual(got, input) || !slicesEqual(indexes, []int{0, 1}) {
+		t.Errorf("Each() = %v, indexes %v", got, indexes)
+	}
+	if &got[0] != &input[0] {
+		t.Error("Each() should return the input slice itself")
+	}
+}
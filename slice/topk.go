@@ -0,0 +1,57 @@
+package slice
+
+import "container/heap"
+
+// boundedHeap is a heap ordered by less, so its root is the "smallest" element.
+type boundedHeap[In any] struct {
+	items []In
+	less  func(a, b In) bool
+}
+
+// Len, Less, Swap, Push and Pop implement heap.Interface.
+func (h *boundedHeap[In]) Len() int           { return len(h.items) }
+func (h *boundedHeap[In]) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+func (h *boundedHeap[In]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *boundedHeap[In]) Push(x any)         { h.items = append(h.items, x.(In)) }
+func (h *boundedHeap[In]) Pop() any {
+	n := len(h.items) - 1
+	item := h.items[n]
+	h.items = h.items[:n]
+	return item
+}
+
+// TopK returns the k largest elements of the slice according to less, ordered from largest to smallest.
+// It keeps a heap of at most k elements, so it runs in O(n log k) without sorting the whole input.
+// If k >= len(input), all elements are returned sorted. Returns nil if k <= 0 or the input is empty.
+func TopK[In any](input []In, k int, less func(a, b In) bool) []In {
+	if len(input) == 0 || k <= 0 || less == nil {
+		return nil
+	}
+	h := &boundedHeap[In]{items: make([]In, 0, min(k, len(input))), less: less}
+	for _, item := range input {
+		if h.Len() < k {
+			heap.Push(h, item)
+			continue
+		}
+		if less(h.items[0], item) {
+			h.items[0] = item
+			heap.Fix(h, 0)
+		}
+	}
+
+	result := make([]In, h.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = heap.Pop(h).(In)
+	}
+	return result
+}
+
+// BottomK returns the k smallest elements of the slice according to less, ordered from smallest to largest.
+// It keeps a heap of at most k elements, so it runs in O(n log k) without sorting the whole input.
+// If k >= len(input), all elements are returned sorted. Returns nil if k <= 0 or the input is empty.
+func BottomK[In any](input []In, k int, less func(a, b In) bool) []In {
+	if less == nil {
+		return nil
+	}
+	return TopK(input, k, func(a, b In) bool { return less(b, a) })
+}
@@ -0,0 +1,46 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestTopK(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	tests := []struct {
+		name  string
+		input []int
+		k     int
+		want  []int
+	}{
+		{name: "nil input", input: nil, k: 3, want: nil},
+		{name: "zero k", input: []int{1, 2}, k: 0, want: nil},
+		{name: "normal case", input: []int{5, 1, 9, 3, 7, 2}, k: 3, want: []int{9, 7, 5}},
+		{name: "with duplicates", input: []int{4, 4, 1, 4, 2}, k: 2, want: []int{4, 4}},
+		{name: "k larger than input", input: []int{2, 3, 1}, k: 10, want: []int{3, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slice.TopK(tt.input, tt.k, less)
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("TopK() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBottomK(t *testing.T) {
+	type score struct {
+		Name  string
+		Value int
+	}
+	input := []score{{"a", 50}, {"b", 10}, {"c", 30}, {"d", 20}}
+	got := slice.BottomK(input, 2, func(a, b score) bool { return a.Value < b.Value })
+	want := []score{{"b", 10}, {"d", 20}}
+	if !slicesEqual(got, want) {
+		t.Errorf("BottomK() = %v, want %v", got, want)
+	}
+}
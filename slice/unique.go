@@ -0,0 +1,81 @@
+package slice
+
+// Duplicate describes a value that occurs more than once in a slice.
+type Duplicate[K comparable] struct {
+	// Key is the duplicated value, or the key returned by keyFn for DuplicatesBy.
+	Key K
+	// Indexes lists the position of every occurrence, in ascending order.
+	Indexes []int
+}
+
+// AllUnique returns true if no element appears more than once in the slice.
+// Returns true for empty slices.
+func AllUnique[In comparable](input []In) bool {
+	seen := make(map[In]struct{}, len(input))
+	for _, item := range input {
+		if _, ok := seen[item]; ok {
+			return false
+		}
+		seen[item] = struct{}{}
+	}
+	return true
+}
+
+// Duplicates reports every element that appears more than once in the slice, with the
+// indexes of all its occurrences, ordered by first occurrence.
+// Returns nil if all elements are unique.
+func Duplicates[In comparable](input []In) []Duplicate[In] {
+	return DuplicatesBy(input, func(item In) In { return item })
+}
+
+// DuplicatesBy reports every key returned by keyFn for more than one element, with the
+// indexes of all those elements, ordered by first occurrence.
+// Returns nil if all keys are unique.
+func DuplicatesBy[In any, K comparable](input []In, keyFn func(item In) K) []Duplicate[K] {
+	if len(input) < 2 || keyFn == nil {
+		return nil
+	}
+	var (
+		order   []K
+		indexes = make(map[K][]int, len(input))
+	)
+	for i, item := range input {
+		k := keyFn(item)
+		if _, ok := indexes[k]; !ok {
+			order = append(order, k)
+		}
+		indexes[k] = append(indexes[k], i)
+	}
+
+	var result []Duplicate[K]
+	for _, k := range order {
+		if len(indexes[k]) > 1 {
+			result = append(result, Duplicate[K]{Key: k, Indexes: indexes[k]})
+		}
+	}
+	return result
+}
+
+// DedupAdjacent collapses runs of consecutive equal elements into one, like Unix uniq.
+// Non-adjacent duplicates are kept. The input is not modified.
+// Returns nil if the input is empty.
+func DedupAdjacent[In comparable](input []In) []In {
+	return DedupAdjacentBy(input, func(a, b In) bool { return a == b })
+}
+
+// DedupAdjacentBy collapses runs of consecutive elements for which eq reports true
+// into the first element of each run. The input is not modified.
+// Returns nil if the input is empty or eq is nil.
+func DedupAdjacentBy[In any](input []In, eq func(a, b In) bool) []In {
+	if len(input) == 0 || eq == nil {
+		return nil
+	}
+	result := make([]In, 0, len(input))
+	result = append(result, input[0])
+	for _, item := range input[1:] {
+		if !eq(result[len(result)-1], item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
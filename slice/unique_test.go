@@ -0,0 +1,84 @@
+package slice_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestAllUnique(t *testing.T) {
+	if !slice.AllUnique([]int{1, 2, 3}) {
+		t.Error("AllUnique() = false for unique input")
+	}
+	if slice.AllUnique([]int{1, 2, 1}) {
+		t.Error("AllUnique() = true for duplicated input")
+	}
+	if !slice.AllUnique[string](nil) {
+		t.Error("AllUnique() = false for nil input")
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	got := slice.Duplicates([]string{"a", "b", "a", "c", "b", "a"})
+	want := []slice.Duplicate[string]{
+		{Key: "a", Indexes: []int{0, 2, 5}},
+		{Key: "b", Indexes: []int{1, 4}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Duplicates() = %v, want %v", got, want)
+	}
+	if got := slice.Duplicates([]int{1, 2, 3}); got != nil {
+		t.Errorf("Duplicates() = %v, want nil", got)
+	}
+}
+
+func TestDuplicatesBy(t *testing.T) {
+	emails := []string{"A@x.com", "b@x.com", "a@X.com"}
+	got := slice.DuplicatesBy(emails, strings.ToLower)
+	want := []slice.Duplicate[string]{{Key: "a@x.com", Indexes: []int{0, 2}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DuplicatesBy() = %v, want %v", got, want)
+	}
+}
+
+func TestDedupAdjacent(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+	}{
+		{name: "runs", input: []int{1, 1, 2, 2, 2, 1, 3, 3}, want: []int{1, 2, 1, 3}},
+		{name: "no runs", input: []int{1, 2, 1}, want: []int{1, 2, 1}},
+		{name: "single", input: []int{5}, want: []int{5}},
+		{name: "nil", input: nil, want: nil},
+		{name: "empty", input: []int{}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slice.DedupAdjacent(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DedupAdjacent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDedupAdjacentBy(t *testing.T) {
+	type sample struct {
+		At    int
+		Value float64
+	}
+	input := []sample{{1, 0.5}, {2, 0.5}, {3, 0.7}, {4, 0.7}, {5, 0.5}}
+	got := slice.DedupAdjacentBy(input, func(a, b sample) bool { return a.Value == b.Value })
+	want := []sample{{1, 0.5}, {3, 0.7}, {5, 0.5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DedupAdjacentBy() = %v, want %v", got, want)
+	}
+	if input[1].At != 2 {
+		t.Errorf("DedupAdjacentBy() modified input: %v", input)
+	}
+	if got := slice.DedupAdjacentBy(input, nil); got != nil {
+		t.Errorf("DedupAdjacentBy() with nil eq = %v, want nil", got)
+	}
+}
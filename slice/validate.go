@@ -0,0 +1,75 @@
+package slice
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrZeroValue is reported by NonZero for zero-valued elements.
+	ErrZeroValue = errors.New("slice: value is zero")
+	// ErrDuplicate is reported by UniqueBy for elements whose key was already seen.
+	ErrDuplicate = errors.New("slice: duplicate value")
+	// ErrOutOfRange is reported by InRange for elements outside the allowed range.
+	ErrOutOfRange = errors.New("slice: value out of range")
+)
+
+// Rule checks a single element of a slice, returning an error describing the violation.
+type Rule[In any] func(i int, item In) error
+
+// Validate checks every element of the slice against every rule and returns a SliceError
+// holding each violation, in element order and then rule order, or nil if there are none.
+func Validate[In any](input []In, rules ...Rule[In]) error {
+	var errs SliceError[In]
+	for i, item := range input {
+		for _, rule := range rules {
+			if err := rule(i, item); err != nil {
+				errs = append(errs, &ElemError[In]{Index: i, Value: item, Err: err})
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// NonZero returns a Rule that rejects zero-valued elements with ErrZeroValue.
+func NonZero[In comparable]() Rule[In] {
+	return func(_ int, item In) error {
+		var zero In
+		if item == zero {
+			return ErrZeroValue
+		}
+		return nil
+	}
+}
+
+// UniqueBy returns a Rule that rejects elements whose key was already seen, wrapping ErrDuplicate.
+// The rule tracks keys for a single pass over a slice and starts afresh at index 0,
+// so it can be reused across Validate calls but not shared between concurrent ones.
+func UniqueBy[In any, K comparable](key func(item In) K) Rule[In] {
+	var seen map[K]int
+	return func(i int, item In) error {
+		if i == 0 || seen == nil {
+			seen = make(map[K]int)
+		}
+		k := key(item)
+		if first, ok := seen[k]; ok {
+			return fmt.Errorf("%w: %v (first seen at index %d)", ErrDuplicate, k, first)
+		}
+		seen[k] = i
+		return nil
+	}
+}
+
+// InRange returns a Rule that rejects elements outside [lo, hi], wrapping ErrOutOfRange.
+func InRange[In cmp.Ordered](lo, hi In) Rule[In] {
+	return func(_ int, item In) error {
+		if item < lo || item > hi {
+			return fmt.Errorf("%w: %v not in [%v, %v]", ErrOutOfRange, item, lo, hi)
+		}
+		return nil
+	}
+}
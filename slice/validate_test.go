@@ -0,0 +1,68 @@
+package slice_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestValidate(t *testing.T) {
+	ages := []int{30, 0, 150, 42, 0}
+
+	err := slice.Validate(ages, slice.NonZero[int](), slice.InRange(1, 120))
+	var sErr slice.SliceError[int]
+	if !errors.As(err, &sErr) {
+		t.Fatalf("expected SliceError, got %v", err)
+	}
+
+	var indexes []int
+	for _, e := range sErr {
+		indexes = append(indexes, e.Index)
+	}
+	// Index 1 and 4 violate both rules.
+	if !slicesEqual(indexes, []int{1, 1, 2, 4, 4}) {
+		t.Errorf("violation indexes = %v, want [1 1 2 4 4]", indexes)
+	}
+	if !errors.Is(sErr.OriginAt(0), slice.ErrZeroValue) || !errors.Is(sErr.OriginAt(1), slice.ErrOutOfRange) {
+		t.Errorf("unexpected errors for index 1: %v", err)
+	}
+
+	if err := slice.Validate([]int{1, 2}, slice.InRange(1, 2)); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := slice.Validate[int](nil, slice.NonZero[int]()); err != nil {
+		t.Errorf("expected no error for nil input, got %v", err)
+	}
+}
+
+func TestValidate_CustomRule(t *testing.T) {
+	notBlank := func(i int, s string) error {
+		if strings.TrimSpace(s) == "" {
+			return errors.New("blank")
+		}
+		return nil
+	}
+	err := slice.Validate([]string{"a", " "}, notBlank)
+	var sErr slice.SliceError[string]
+	if !errors.As(err, &sErr) || len(sErr) != 1 || sErr[0].Index != 1 {
+		t.Errorf("expected one violation at index 1, got %v", err)
+	}
+}
+
+func TestUniqueBy(t *testing.T) {
+	rule := slice.UniqueBy(func(u user) int { return u.ID })
+	users := []user{{ID: 1}, {ID: 2}, {ID: 1}}
+
+	for range 2 {
+		err := slice.Validate(users, rule)
+		var sErr slice.SliceError[user]
+		if !errors.As(err, &sErr) || len(sErr) != 1 || sErr[0].Index != 2 {
+			t.Fatalf("expected one duplicate at index 2, got %v", err)
+		}
+		if !errors.Is(err, slice.ErrDuplicate) || !strings.Contains(err.Error(), "index 0") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
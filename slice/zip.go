@@ -0,0 +1,23 @@
+package slice
+
+// ZipWith combines the elements of a and b at the same index with combine.
+// The result has the length of the shorter slice.
+func ZipWith[A, B, C any](a []A, b []B, combine func(A, B) C) []C {
+	n := min(len(a), len(b))
+	result := make([]C, n)
+	for i := range n {
+		result[i] = combine(a[i], b[i])
+	}
+	return result
+}
+
+// ZipWith3 combines the elements of a, b and c at the same index with combine.
+// The result has the length of the shortest slice.
+func ZipWith3[A, B, C, D any](a []A, b []B, c []C, combine func(A, B, C) D) []D {
+	n := min(len(a), len(b), len(c))
+	result := make([]D, n)
+	for i := range n {
+		result[i] = combine(a[i], b[i], c[i])
+	}
+	return result
+}
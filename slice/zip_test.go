@@ -0,0 +1,36 @@
+package slice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cirius-go/devutil/slice"
+)
+
+func TestZipWith(t *testing.T) {
+	tests := []struct {
+		name   string
+		names  []string
+		scores []int
+		want   []string
+	}{
+		{name: "equal length", names: []string{"a", "b"}, scores: []int{1, 2}, want: []string{"a=1", "b=2"}},
+		{name: "shorter second", names: []string{"a", "b", "c"}, scores: []int{1}, want: []string{"a=1"}},
+		{name: "empty", names: nil, scores: []int{1}, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slice.ZipWith(tt.names, tt.scores, func(n string, s int) string { return fmt.Sprintf("%s=%d", n, s) })
+			if !slicesEqual(got, tt.want) {
+				t.Errorf("ZipWith() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestZipWith3(t *testing.T) {
+	got := slice.ZipWith3([]int{1, 2, 3}, []int{10, 20}, []int{100, 200, 300}, func(a, b, c int) int { return a + b + c })
+	if want := []int{111, 222}; !slicesEqual(got, want) {
+		t.Errorf("ZipWith3() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,106 @@
+// Package stats provides small numeric summaries such as histograms.
+package stats
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/cirius-go/devutil/internal/numeric"
+)
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number = numeric.Number
+
+// Bucket is a histogram bucket counting the values in [Lo, Hi).
+// The last bucket of a histogram also counts values equal to its Hi.
+type Bucket[N Number] struct {
+	Lo, Hi N
+	Count  int
+}
+
+// String returns the bucket range, such as "[10, 20)".
+func (b Bucket[N]) String() string {
+	return fmt.Sprintf("[%v, %v)", b.Lo, b.Hi)
+}
+
+// Histogram counts the values of input into the buckets delimited by bounds: one
+// bucket for each pair of adjacent bounds, in ascending order. Bounds are sorted and
+// deduplicated first. Values below the first bound or above the last one, and NaNs,
+// are not counted. Returns nil if there are fewer than two distinct bounds.
+func Histogram[N Number](input []N, bounds []N) []Bucket[N] {
+	bounds = slices.Clone(bounds)
+	slices.Sort(bounds)
+	bounds = slices.Compact(bounds)
+	if len(bounds) < 2 {
+		return nil
+	}
+
+	buckets := make([]Bucket[N], len(bounds)-1)
+	for i := range buckets {
+		buckets[i] = Bucket[N]{Lo: bounds[i], Hi: bounds[i+1]}
+	}
+	last := bounds[len(bounds)-1]
+	for _, v := range input {
+		if v != v || v < bounds[0] || v > last { // v != v catches NaN
+			continue
+		}
+		// Index of the first bound greater than v, minus one, is the bucket of v.
+		i := sort.Search(len(bounds), func(i int) bool { return bounds[i] > v }) - 1
+		buckets[min(i, len(buckets)-1)].Count++
+	}
+	return buckets
+}
+
+// AutoBuckets returns bounds splitting the range of input into n buckets of equal
+// width, for use with Histogram. For integer types, bounds are truncated, and bounds
+// that collapse together are merged, so there may be fewer than n buckets.
+// NaNs are ignored. If all values are equal it returns the bounds of a single bucket
+// holding them. Returns nil if input has no values other than NaN, or n < 1.
+func AutoBuckets[N Number](input []N, n int) []N {
+	if n < 1 {
+		return nil
+	}
+	var lo, hi N
+	found := false
+	for _, v := range input {
+		if v != v { // NaN
+			continue
+		}
+		if !found {
+			lo, hi, found = v, v, true
+			continue
+		}
+		lo, hi = min(lo, v), max(hi, v)
+	}
+	if !found {
+		return nil
+	}
+	if lo == hi {
+		return widen(lo)
+	}
+
+	bounds := make([]N, 0, n+1)
+	width := (float64(hi) - float64(lo)) / float64(n)
+	for i := range n {
+		bounds = append(bounds, N(float64(lo)+width*float64(i)))
+	}
+	bounds = append(bounds, hi)
+	return slices.Compact(bounds)
+}
+
+// widen returns two distinct bounds with v as one of them, so that Histogram
+// produces a single bucket counting v.
+func widen[N Number](v N) []N {
+	if up := v + 1; up > v {
+		return []N{v, up}
+	}
+	if down := v - 1; down < v {
+		return []N{down, v}
+	}
+	// A float so large that 1 is below its precision.
+	if v > 0 {
+		return []N{v, v + v/2}
+	}
+	return []N{v + v/2, v}
+}
@@ -0,0 +1,87 @@
+package stats_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/cirius-go/devutil/stats"
+)
+
+func TestHistogram(t *testing.T) {
+	latencies := []int{5, 12, 18, 20, 35, 50, 99, 100, 101}
+	got := stats.Histogram(latencies, []int{50, 0, 20, 100})
+	want := []stats.Bucket[int]{
+		{Lo: 0, Hi: 20, Count: 3},
+		{Lo: 20, Hi: 50, Count: 2},
+		{Lo: 50, Hi: 100, Count: 3}, // the last bucket includes 100
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Histogram() = %v, want %v", got, want)
+	}
+	if got[0].String() != "[0, 20)" {
+		t.Errorf("String() = %q", got[0].String())
+	}
+}
+
+func TestHistogram_EdgeCases(t *testing.T) {
+	if got := stats.Histogram([]int{1, 2}, []int{5, 5}); got != nil {
+		t.Errorf("Histogram() with one distinct bound = %v, want nil", got)
+	}
+	got := stats.Histogram([]float64{0.5, math.NaN(), 1.5}, []float64{0, 1, 2})
+	if got[0].Count != 1 || got[1].Count != 1 {
+		t.Errorf("Histogram() with NaN = %v", got)
+	}
+}
+
+func TestAutoBuckets(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []float64
+		n     int
+		want  []float64
+	}{
+		{name: "even split", input: []float64{0, 3, 10, 7}, n: 5, want: []float64{0, 2, 4, 6, 8, 10}},
+		{name: "single value", input: []float64{4, 4}, n: 3, want: []float64{4, 5}},
+		{name: "skips NaN", input: []float64{math.NaN(), 2, math.NaN(), 6}, n: 2, want: []float64{2, 4, 6}},
+		{name: "only NaN", input: []float64{math.NaN()}, n: 2, want: nil},
+		{name: "empty", input: nil, n: 3, want: nil},
+		{name: "no buckets", input: []float64{1, 2}, n: 0, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stats.AutoBuckets(tt.input, tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AutoBuckets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Integer bounds that truncate to the same value are merged.
+	if got := stats.AutoBuckets([]int{0, 1, 2}, 4); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Errorf("AutoBuckets() for ints = %v, want [0 1 2]", got)
+	}
+
+	// All-equal input round-trips to one bucket holding every value, even at the type's limits.
+	for _, v := range []int{7, math.MaxInt, math.MinInt} {
+		got := stats.Histogram([]int{v, v, v}, stats.AutoBuckets([]int{v, v, v}, 3))
+		if len(got) != 1 || got[0].Count != 3 {
+			t.Errorf("Histogram() of equal values %d = %v, want one bucket of 3", v, got)
+		}
+	}
+	for _, v := range []float64{-2.5, 1e300, -1e300} {
+		got := stats.Histogram([]float64{v, v}, stats.AutoBuckets([]float64{v, v}, 3))
+		if len(got) != 1 || got[0].Count != 2 {
+			t.Errorf("Histogram() of equal values %v = %v, want one bucket of 2", v, got)
+		}
+	}
+
+	// Every value lands in a bucket.
+	input := []int{3, 17, 42, 8, 99, 63}
+	total := 0
+	for _, b := range stats.Histogram(input, stats.AutoBuckets(input, 4)) {
+		total += b.Count
+	}
+	if total != len(input) {
+		t.Errorf("expected %d values counted, got %d", len(input), total)
+	}
+}
@@ -0,0 +1,173 @@
+// Package stream reads delimited records from an io.Reader as lazy iterators.
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"iter"
+)
+
+const (
+	defaultBufferSize   = 64 * 1024
+	defaultMaxTokenSize = 1024 * 1024
+)
+
+// Option configures how a stream is read.
+type Option func(*options)
+
+type options struct {
+	bufferSize   int
+	maxTokenSize int
+	onError      func(error)
+}
+
+// WithBufferSize sets the initial size of the read buffer. Defaults to 64 KiB.
+func WithBufferSize(n int) Option {
+	return func(o *options) {
+		if n > 0 {
+			o.bufferSize = n
+		}
+	}
+}
+
+// WithMaxTokenSize sets the maximum length of a single record. Longer records
+// stop the stream with an error wrapping bufio.ErrTooLong. Defaults to 1 MiB.
+func WithMaxTokenSize(n int) Option {
+	return func(o *options) {
+		if n > 0 {
+			o.maxTokenSize = n
+		}
+	}
+}
+
+// OnError registers fn to be called if reading fails, in addition to the error being
+// reported by Records.Err. The stream stops after the error.
+func OnError(fn func(error)) Option {
+	return func(o *options) {
+		o.onError = fn
+	}
+}
+
+// Records is a lazy sequence of records read from an io.Reader.
+// Iterate it with All, then call Err to find out whether it stopped because of an error.
+type Records struct {
+	r     io.Reader
+	split bufio.SplitFunc
+	o     options
+	err   error
+}
+
+// Lines returns the lines of r, without their trailing "\n" or "\r\n".
+// Data is read only as the consumer pulls values, so slow consumers naturally
+// throttle reading and the input is never loaded into memory as a whole.
+func Lines(r io.Reader, opts ...Option) *Records {
+	return newRecords(r, bufio.ScanLines, opts)
+}
+
+// Split returns the records of r separated by delim, without the delimiter.
+// A final record that is not terminated by delim is still yielded if it is not empty.
+func Split(r io.Reader, delim byte, opts ...Option) *Records {
+	return newRecords(r, splitOn(delim), opts)
+}
+
+func newRecords(r io.Reader, split bufio.SplitFunc, opts []Option) *Records {
+	o := options{
+		bufferSize:   defaultBufferSize,
+		maxTokenSize: defaultMaxTokenSize,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Records{r: r, split: split, o: o}
+}
+
+// All returns an iterator over the records. It reads from the underlying reader,
+// so it should only be iterated once.
+func (s *Records) All() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		sc := bufio.NewScanner(s.r)
+		sc.Buffer(make([]byte, 0, min(s.o.bufferSize, s.o.maxTokenSize)), s.o.maxTokenSize)
+		sc.Split(s.split)
+		for sc.Scan() {
+			if !yield(sc.Text()) {
+				return
+			}
+		}
+		if err := sc.Err(); err != nil {
+			s.err = fmt.Errorf("stream: %w", err)
+			if s.o.onError != nil {
+				s.o.onError(s.err)
+			}
+		}
+	}
+}
+
+// Err returns the error that stopped iteration, or nil if the input was read to the end
+// or the consumer stopped early. Records longer than the maximum token size are
+// reported as an error wrapping bufio.ErrTooLong.
+func (s *Records) Err() error {
+	return s.err
+}
+
+func splitOn(delim byte) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+		if i := bytes.IndexByte(data, delim); i >= 0 {
+			return i + 1, data[:i], nil
+		}
+		if atEOF {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	}
+}
+
+// Filter returns an iterator over the values of seq that satisfy predicate.
+func Filter[T any](seq iter.Seq[T], predicate func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if predicate(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns an iterator over the results of applying mapper to each value of seq.
+func Map[In, Out any](seq iter.Seq[In], mapper func(In) Out) iter.Seq[Out] {
+	return func(yield func(Out) bool) {
+		for v := range seq {
+			if !yield(mapper(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Chunk returns an iterator over consecutive batches of up to size values from seq.
+// The last batch may be smaller. If size <= 0 it defaults to 1.
+// Each batch is a new slice and may be retained by the caller.
+func Chunk[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size <= 0 {
+		size = 1
+	}
+	return func(yield func([]T) bool) {
+		batch := make([]T, 0, size)
+		for v := range seq {
+			batch = append(batch, v)
+			if len(batch) == size {
+				if !yield(batch) {
+					return
+				}
+				batch = make([]T, 0, size)
+			}
+		}
+		if len(batch) > 0 {
+			yield(batch)
+		}
+	}
+}
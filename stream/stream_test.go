@@ -0,0 +1,157 @@
+package stream_test
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/cirius-go/devutil/stream"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{name: "unix newlines", input: "a\nb\nc\n", want: []string{"a", "b", "c"}},
+		{name: "windows newlines", input: "a\r\nb\r\n", want: []string{"a", "b"}},
+		{name: "no trailing newline", input: "a\nb", want: []string{"a", "b"}},
+		{name: "blank lines kept", input: "a\n\nb\n", want: []string{"a", "", "b"}},
+		{name: "empty input", input: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Collect(stream.Lines(strings.NewReader(tt.input)).All())
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	got := slices.Collect(stream.Split(strings.NewReader("a,b,,c"), ',').All())
+	want := []string{"a", "b", "", "c"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Split() = %q, want %q", got, want)
+	}
+
+	got = slices.Collect(stream.Split(strings.NewReader("a\x00b\x00"), 0).All())
+	want = []string{"a", "b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Split() = %q, want %q", got, want)
+	}
+}
+
+func TestLines_ReadsLazily(t *testing.T) {
+	r := &countingReader{r: iotest.OneByteReader(strings.NewReader("a\nb\nc\nd\n"))}
+	for line := range stream.Lines(r, stream.WithBufferSize(1)).All() {
+		if line == "a" {
+			break
+		}
+	}
+	if r.n >= 8 {
+		t.Errorf("expected partial read, read %d bytes", r.n)
+	}
+}
+
+func TestLines_Errors(t *testing.T) {
+	var gotErr error
+	onErr := stream.OnError(func(err error) { gotErr = err })
+
+	long := strings.Repeat("x", 32) + "\n"
+	got := slices.Collect(stream.Lines(strings.NewReader("ok\n"+long), stream.WithMaxTokenSize(16), onErr).All())
+	if !reflect.DeepEqual(got, []string{"ok"}) {
+		t.Errorf("Lines() = %q, want [ok]", got)
+	}
+	if !errors.Is(gotErr, bufio.ErrTooLong) {
+		t.Errorf("expected ErrTooLong, got %v", gotErr)
+	}
+
+	gotErr = nil
+	readErr := errors.New("boom")
+	_ = slices.Collect(stream.Lines(iotest.ErrReader(readErr), onErr).All())
+	if !errors.Is(gotErr, readErr) {
+		t.Errorf("expected read error, got %v", gotErr)
+	}
+}
+
+func TestLines_ErrWithoutOnError(t *testing.T) {
+	long := strings.Repeat("x", 32) + "\n"
+	lines := stream.Lines(strings.NewReader("ok\n"+long+"after\n"), stream.WithMaxTokenSize(16))
+	got := slices.Collect(lines.All())
+	if !reflect.DeepEqual(got, []string{"ok"}) {
+		t.Errorf("Lines() = %q, want [ok]", got)
+	}
+	if err := lines.Err(); !errors.Is(err, bufio.ErrTooLong) {
+		t.Errorf("expected Err() to report ErrTooLong, got %v", err)
+	}
+
+	readErr := errors.New("boom")
+	lines = stream.Lines(iotest.ErrReader(readErr))
+	_ = slices.Collect(lines.All())
+	if err := lines.Err(); !errors.Is(err, readErr) {
+		t.Errorf("expected Err() to report the read error, got %v", err)
+	}
+
+	lines = stream.Lines(strings.NewReader("a\nb\n"))
+	_ = slices.Collect(lines.All())
+	if err := lines.Err(); err != nil {
+		t.Errorf("expected nil error at EOF, got %v", err)
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	log := "INFO start\nERROR disk\nINFO tick\nERROR net\nERROR cpu\n"
+
+	lines := stream.Lines(strings.NewReader(log))
+	errs := stream.Filter(lines.All(), func(l string) bool {
+		return strings.HasPrefix(l, "ERROR")
+	})
+	msgs := stream.Map(errs, func(l string) string { return strings.TrimPrefix(l, "ERROR ") })
+
+	var got [][]string
+	for batch := range stream.Chunk(msgs, 2) {
+		got = append(got, batch)
+	}
+	want := [][]string{{"disk", "net"}, {"cpu"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("pipeline = %q, want %q", got, want)
+	}
+	if err := lines.Err(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestChunk_EarlyStop(t *testing.T) {
+	seq := slices.Values([]int{1, 2, 3, 4, 5})
+	var got [][]int
+	for batch := range stream.Chunk(seq, 0) {
+		got = append(got, batch)
+		if len(got) == 2 {
+			break
+		}
+	}
+	want := [][]int{{1}, {2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Chunk() = %v, want %v", got, want)
+	}
+}
+
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += n
+	return n, err
+}
@@ -0,0 +1,167 @@
+// Package trie provides a prefix tree keyed by strings.
+package trie
+
+import "slices"
+
+// node is a single byte position in the trie.
+type node[V any] struct {
+	children map[byte]*node[V]
+	value    V
+	hasValue bool
+}
+
+// Trie is a prefix tree mapping string keys to values of type V.
+// The zero value is an empty trie ready to use.
+// A Trie is not safe for concurrent use.
+type Trie[V any] struct {
+	root node[V]
+	size int
+}
+
+// New creates an empty Trie.
+func New[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+// Len returns the number of keys stored in the trie.
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+// Insert stores value under key, replacing any existing value.
+func (t *Trie[V]) Insert(key string, value V) {
+	n := &t.root
+	for i := 0; i < len(key); i++ {
+		if n.children == nil {
+			n.children = make(map[byte]*node[V])
+		}
+		child, ok := n.children[key[i]]
+		if !ok {
+			child = &node[V]{}
+			n.children[key[i]] = child
+		}
+		n = child
+	}
+	if !n.hasValue {
+		t.size++
+	}
+	n.value = value
+	n.hasValue = true
+}
+
+// Get returns the value stored under key and true, or the zero value and false
+// if the key is not present.
+func (t *Trie[V]) Get(key string) (V, bool) {
+	n := t.find(key)
+	if n == nil || !n.hasValue {
+		var zero V
+		return zero, false
+	}
+	return n.value, true
+}
+
+// Delete removes key from the trie and reports whether it was present.
+// Nodes left without values or children are pruned.
+func (t *Trie[V]) Delete(key string) bool {
+	path := make([]*node[V], 0, len(key)+1)
+	n := &t.root
+	path = append(path, n)
+	for i := 0; i < len(key); i++ {
+		n = n.children[key[i]]
+		if n == nil {
+			return false
+		}
+		path = append(path, n)
+	}
+	if !n.hasValue {
+		return false
+	}
+	var zero V
+	n.value = zero
+	n.hasValue = false
+	t.size--
+
+	for i := len(key); i > 0; i-- {
+		cur := path[i]
+		if cur.hasValue || len(cur.children) > 0 {
+			break
+		}
+		delete(path[i-1].children, key[i-1])
+	}
+	return true
+}
+
+// LongestPrefix returns the longest stored key that is a prefix of s, along with its value.
+// The bool is false if no stored key is a prefix of s.
+func (t *Trie[V]) LongestPrefix(s string) (string, V, bool) {
+	var (
+		n       = &t.root
+		bestLen = -1
+		best    V
+	)
+	if n.hasValue {
+		bestLen, best = 0, n.value
+	}
+	for i := 0; i < len(s); i++ {
+		n = n.children[s[i]]
+		if n == nil {
+			break
+		}
+		if n.hasValue {
+			bestLen, best = i+1, n.value
+		}
+	}
+	if bestLen < 0 {
+		var zero V
+		return "", zero, false
+	}
+	return s[:bestLen], best, true
+}
+
+// WalkPrefix calls fn for every key starting with prefix, in lexicographic byte order.
+// Walking stops early if fn returns false.
+func (t *Trie[V]) WalkPrefix(prefix string, fn func(key string, value V) bool) {
+	if fn == nil {
+		return
+	}
+	n := t.find(prefix)
+	if n == nil {
+		return
+	}
+	buf := []byte(prefix)
+	walk(n, buf, fn)
+}
+
+// find returns the node for key, or nil if no key has it as a prefix.
+func (t *Trie[V]) find(key string) *node[V] {
+	n := &t.root
+	for i := 0; i < len(key); i++ {
+		n = n.children[key[i]]
+		if n == nil {
+			return nil
+		}
+	}
+	return n
+}
+
+// walk visits n and its descendants depth-first in byte order.
+// It returns false if fn asked to stop.
+func walk[V any](n *node[V], key []byte, fn func(key string, value V) bool) bool {
+	if n.hasValue && !fn(string(key), n.value) {
+		return false
+	}
+	if len(n.children) == 0 {
+		return true
+	}
+	edges := make([]byte, 0, len(n.children))
+	for b := range n.children {
+		edges = append(edges, b)
+	}
+	slices.Sort(edges)
+	for _, b := range edges {
+		if !walk(n.children[b], append(key, b), fn) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,112 @@
+package trie
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTrie_InsertGet(t *testing.T) {
+	tr := New[int]()
+	tr.Insert("car", 1)
+	tr.Insert("cart", 2)
+	tr.Insert("", 0)
+	tr.Insert("car", 3)
+
+	if tr.Len() != 3 {
+		t.Errorf("Expected length 3, got %d", tr.Len())
+	}
+	if v, ok := tr.Get("car"); !ok || v != 3 {
+		t.Errorf("Expected car=3, got %d found=%v", v, ok)
+	}
+	if v, ok := tr.Get(""); !ok || v != 0 {
+		t.Errorf("Expected empty key to be stored, got %d found=%v", v, ok)
+	}
+	if _, ok := tr.Get("ca"); ok {
+		t.Error("Expected intermediate prefix not to be a key")
+	}
+	if _, ok := tr.Get("cars"); ok {
+		t.Error("Expected missing key not to be found")
+	}
+}
+
+func TestTrie_Delete(t *testing.T) {
+	var tr Trie[string]
+	tr.Insert("a", "a")
+	tr.Insert("abc", "abc")
+
+	if tr.Delete("ab") {
+		t.Error("Expected Delete of missing key to return false")
+	}
+	if !tr.Delete("abc") {
+		t.Error("Expected Delete of existing key to return true")
+	}
+	if _, ok := tr.Get("abc"); ok {
+		t.Error("Expected deleted key to be gone")
+	}
+	if v, ok := tr.Get("a"); !ok || v != "a" {
+		t.Error("Expected sibling key to survive deletion")
+	}
+	if len(tr.root.children['a'].children) != 0 {
+		t.Error("Expected empty nodes to be pruned")
+	}
+	if tr.Len() != 1 {
+		t.Errorf("Expected length 1, got %d", tr.Len())
+	}
+}
+
+func TestTrie_LongestPrefix(t *testing.T) {
+	var tr Trie[string]
+	tr.Insert("/api", "api")
+	tr.Insert("/api/users", "users")
+
+	tests := []struct {
+		input   string
+		wantKey string
+		wantVal string
+		wantOK  bool
+	}{
+		{"/api/users/42", "/api/users", "users", true},
+		{"/api/orders", "/api", "api", true},
+		{"/api", "/api", "api", true},
+		{"/health", "", "", false},
+	}
+	for _, tt := range tests {
+		key, val, ok := tr.LongestPrefix(tt.input)
+		if key != tt.wantKey || val != tt.wantVal || ok != tt.wantOK {
+			t.Errorf("LongestPrefix(%q) = (%q, %q, %v), want (%q, %q, %v)",
+				tt.input, key, val, ok, tt.wantKey, tt.wantVal, tt.wantOK)
+		}
+	}
+}
+
+func TestTrie_WalkPrefix(t *testing.T) {
+	var tr Trie[int]
+	for i, k := range []string{"beta.search", "beta", "alpha", "beta.checkout", "betamax"} {
+		tr.Insert(k, i)
+	}
+
+	var keys []string
+	tr.WalkPrefix("beta", func(key string, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	expected := []string{"beta", "beta.checkout", "beta.search", "betamax"}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Expected %v, got %v", expected, keys)
+	}
+
+	keys = nil
+	tr.WalkPrefix("", func(key string, _ int) bool {
+		keys = append(keys, key)
+		return len(keys) < 2
+	})
+	expected = []string{"alpha", "beta"}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Expected early stop with %v, got %v", expected, keys)
+	}
+
+	tr.WalkPrefix("gamma", func(string, int) bool {
+		t.Error("Expected no keys for missing prefix")
+		return true
+	})
+}